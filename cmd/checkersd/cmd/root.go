@@ -37,6 +37,7 @@ import (
 
 	"github.com/bekauz/checkers/app"
 	appparams "github.com/bekauz/checkers/app/params"
+	checkerscli "github.com/bekauz/checkers/x/checkers/client/cli"
 )
 
 // NewRootCmd creates a new root command for a Cosmos SDK application
@@ -118,6 +119,7 @@ func initRootCmd(
 		tmcli.NewCompletionCmd(rootCmd, true),
 		debug.Cmd(),
 		config.Cmd(),
+		checkerscli.GetRulesCmd(),
 		// this line is used by starport scaffolding # root/commands
 	)
 
@@ -28,6 +28,9 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdShowSystemInfo())
 	cmd.AddCommand(CmdListStoredGame())
 	cmd.AddCommand(CmdShowStoredGame())
+	cmd.AddCommand(CmdListPlayerInfo())
+	cmd.AddCommand(CmdShowPlayerInfo())
+	cmd.AddCommand(CmdExportPdn())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
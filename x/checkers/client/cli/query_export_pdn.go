@@ -0,0 +1,45 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/bekauz/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+func CmdExportPdn() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-pdn [game-index]",
+		Short: "prints a game and its moves in Portable Draughts Notation",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argGameIndex := args[0]
+
+			params := &types.QueryGetStoredGameRequest{
+				Index: argGameIndex,
+			}
+
+			res, err := queryClient.StoredGame(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			pdn, err := res.StoredGame.ExportPdn()
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(pdn.String())
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/bekauz/checkers/x/checkers/types"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -9,6 +10,8 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const flagStatus = "status"
+
 func CmdListStoredGame() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "list-stored-game",
@@ -21,10 +24,16 @@ func CmdListStoredGame() *cobra.Command {
 				return err
 			}
 
+			argStatus, err := cmd.Flags().GetString(flagStatus)
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
 
 			params := &types.QueryAllStoredGameRequest{
 				Pagination: pageReq,
+				Status:     argStatus,
 			}
 
 			res, err := queryClient.StoredGameAll(context.Background(), params)
@@ -36,6 +45,7 @@ func CmdListStoredGame() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(flagStatus, "", fmt.Sprintf("only list games that are %s or %s", types.GameStatusActive, types.GameStatusFinished))
 	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
 	flags.AddQueryFlagsToCmd(cmd)
 
@@ -22,7 +22,9 @@ func networkWithSystemInfoObjects(t *testing.T) (*network.Network, types.SystemI
 	require.NoError(t, cfg.Codec.UnmarshalJSON(cfg.GenesisState[types.ModuleName], &state))
 
 	systemInfo := types.SystemInfo{
-		NextId: 1,
+		NextId:        1,
+		FifoHeadIndex: types.NoFifoIndex,
+		FifoTailIndex: types.NoFifoIndex,
 	}
 	nullify.Fill(&systemInfo)
 	state.SystemInfo = systemInfo
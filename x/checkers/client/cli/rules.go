@@ -0,0 +1,24 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+// GetRulesCmd returns the commands that work on the rules alone, offline.
+func GetRulesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "rules",
+		Short:                      "Offline commands about the rules of the game",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(CmdPerft())
+	cmd.AddCommand(CmdAnalyze())
+	cmd.AddCommand(CmdEndgameBuild())
+
+	return cmd
+}
@@ -0,0 +1,95 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/bekauz/checkers/x/checkers/endgame"
+	"github.com/bekauz/checkers/x/checkers/engine"
+	"github.com/bekauz/checkers/x/checkers/rules"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagEndgame = "endgame"
+	flagWorkers = "workers"
+	flagTime    = "time"
+)
+
+func CmdAnalyze() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "analyze [depth]",
+		Short: "searches the best turn to play, looking depth turns ahead",
+		Long: fmt.Sprintf("Searches the best turn to play, looking depth turns ahead.\n"+
+			"The --%s flag picks the rules among: %s.\n"+
+			"The --%s flag sets the position to analyze, as a FEN or as a board string.\n"+
+			"The --%s flag loads a file saved by endgame-build, giving the exact result of positions with few pieces.",
+			flagVariant, strings.Join(rules.RuleSetNames(), ", "), flagBoard, flagEndgame),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argDepth, err := strconv.Atoi(args[0])
+			if err != nil || argDepth < 1 {
+				return fmt.Errorf("invalid depth: %s", args[0])
+			}
+			game, err := gameFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			argEndgame, err := cmd.Flags().GetString(flagEndgame)
+			if err != nil {
+				return err
+			}
+			argWorkers, err := cmd.Flags().GetInt(flagWorkers)
+			if err != nil {
+				return err
+			}
+			argTime, err := cmd.Flags().GetDuration(flagTime)
+			if err != nil {
+				return err
+			}
+
+			searcher := engine.New()
+			searcher.Workers = argWorkers
+			if argEndgame != "" {
+				searcher.Endgame, err = endgame.ReadFile(argEndgame)
+				if err != nil {
+					return err
+				}
+				if searcher.Endgame.RuleSet != game.RuleSet {
+					return fmt.Errorf("endgame table of %s, not %s", searcher.Endgame.RuleSet.Name, game.RuleSet.Name)
+				}
+			}
+			result, err := searcher.Search(game, engine.Limits{Depth: argDepth, Time: argTime})
+			if err != nil {
+				return err
+			}
+
+			var buf strings.Builder
+			buf.WriteString(fmt.Sprintf("Best: %s\n", game.RuleSet.FormatPdnMove(rules.PdnMove{
+				Path:    result.Best.Path,
+				Capture: len(result.Best.Captured) > 0,
+			})))
+			buf.WriteString(fmt.Sprintf("Score: %d\n", result.Score))
+			buf.WriteString(fmt.Sprintf("Depth: %d\n", result.Depth))
+			buf.WriteString(fmt.Sprintf("Nodes: %d\n", result.Nodes))
+			if searcher.Endgame != nil {
+				if position, found := searcher.Endgame.Probe(game); found && position.Value == endgame.DRAW {
+					buf.WriteString(fmt.Sprintf("Endgame: %s\n", position.Value))
+				} else if found {
+					buf.WriteString(fmt.Sprintf("Endgame: %s in %d plies\n", position.Value, position.Plies))
+				}
+			}
+			_, err = fmt.Fprint(cmd.OutOrStdout(), buf.String())
+			return err
+		},
+	}
+
+	cmd.Flags().String(flagVariant, rules.ENGLISH, "the rules to play by")
+	cmd.Flags().String(flagBoard, "", "the position to analyze, instead of the usual one")
+	cmd.Flags().String(flagEndgame, "", "the endgame table file to use")
+	cmd.Flags().Int(flagWorkers, 1, "the number of goroutines searching")
+	cmd.Flags().Duration(flagTime, 0, "the time after which to stop deepening, such as 5s")
+
+	return cmd
+}
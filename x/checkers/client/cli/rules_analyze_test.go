@@ -0,0 +1,40 @@
+package cli_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bekauz/checkers/x/checkers/client/cli"
+)
+
+func TestAnalyze(t *testing.T) {
+	out, err := clitestutil.ExecTestCLICmd(client.Context{}, cli.CmdAnalyze(), []string{"3", "--board", "B:W10:B6"})
+	require.NoError(t, err)
+	require.Equal(t, "Best: 6x15\nScore: 999999\nDepth: 1\nNodes: 1\n", out.String())
+
+	_, err = clitestutil.ExecTestCLICmd(client.Context{}, cli.CmdAnalyze(), []string{"0"})
+	require.Error(t, err)
+}
+
+func TestAnalyzeWithEndgameTable(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "english.egtb")
+	out, err := clitestutil.ExecTestCLICmd(client.Context{}, cli.CmdEndgameBuild(), []string{"2", path})
+	require.NoError(t, err)
+	require.Contains(t, out.String(), "up to 2 pieces")
+
+	out, err = clitestutil.ExecTestCLICmd(client.Context{}, cli.CmdAnalyze(),
+		[]string{"2", "--board", "B:W30:B25", "--endgame", path, "--workers", "2"})
+	require.NoError(t, err)
+	require.Contains(t, out.String(), "Score: 999987\n")
+	require.Contains(t, out.String(), "Endgame: win in 13 plies\n")
+
+	_, err = clitestutil.ExecTestCLICmd(client.Context{}, cli.CmdAnalyze(),
+		[]string{"2", "--variant", "giveaway", "--endgame", path})
+	require.Error(t, err)
+	_, err = clitestutil.ExecTestCLICmd(client.Context{}, cli.CmdEndgameBuild(), []string{"0", path})
+	require.Error(t, err)
+}
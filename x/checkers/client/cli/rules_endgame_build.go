@@ -0,0 +1,55 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/bekauz/checkers/x/checkers/endgame"
+	"github.com/bekauz/checkers/x/checkers/rules"
+	"github.com/bekauz/checkers/x/checkers/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/spf13/cobra"
+)
+
+func CmdEndgameBuild() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "endgame-build [max-pieces] [file]",
+		Short: "solves every position with up to max-pieces pieces and saves the results to a file",
+		Long: fmt.Sprintf("Solves every position with up to max-pieces pieces, as win, loss or draw with the number of turns left, "+
+			"and saves the results to a file that analyze can load. Each extra piece takes much longer, 4 pieces taking minutes.\n"+
+			"The --%s flag picks the rules among: %s.",
+			flagVariant, strings.Join(rules.RuleSetNames(), ", ")),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argMaxPieces, err := strconv.Atoi(args[0])
+			if err != nil || argMaxPieces < 1 {
+				return fmt.Errorf("invalid number of pieces: %s", args[0])
+			}
+			argFile := args[1]
+			argVariant, err := cmd.Flags().GetString(flagVariant)
+			if err != nil {
+				return err
+			}
+			ruleSet, found := rules.GetRuleSet(argVariant)
+			if !found {
+				return sdkerrors.Wrapf(types.ErrInvalidVariant, "%s", argVariant)
+			}
+
+			table, err := endgame.Build(ruleSet, argMaxPieces)
+			if err != nil {
+				return err
+			}
+			if err := table.WriteFile(argFile); err != nil {
+				return err
+			}
+			_, err = fmt.Fprintf(cmd.OutOrStdout(), "Saved %s positions with up to %d pieces to %s\n",
+				ruleSet.Name, argMaxPieces, argFile)
+			return err
+		},
+	}
+
+	cmd.Flags().String(flagVariant, rules.ENGLISH, "the rules to play by")
+
+	return cmd
+}
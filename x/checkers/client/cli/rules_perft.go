@@ -0,0 +1,98 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/bekauz/checkers/x/checkers/rules"
+	"github.com/bekauz/checkers/x/checkers/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/spf13/cobra"
+)
+
+const flagDivide = "divide"
+
+func CmdPerft() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "perft [depth]",
+		Short: "counts the positions reached after depth turns, to check the move generation",
+		Long: fmt.Sprintf("Counts the positions reached after depth turns, a capture sequence being a single turn.\n"+
+			"The --%s flag picks the rules among: %s.\n"+
+			"The --%s flag sets the position to start from, as a FEN or as a board string.\n"+
+			"The --%s flag prints the count below each turn of the position.",
+			flagVariant, strings.Join(rules.RuleSetNames(), ", "), flagBoard, flagDivide),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argDepth, err := strconv.Atoi(args[0])
+			if err != nil || argDepth < 0 {
+				return fmt.Errorf("invalid depth: %s", args[0])
+			}
+			game, err := gameFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			argDivide, err := cmd.Flags().GetBool(flagDivide)
+			if err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			if !argDivide {
+				nodes, err := game.Perft(argDepth)
+				if err != nil {
+					return err
+				}
+				_, err = fmt.Fprintln(out, nodes)
+				return err
+			}
+			divisions, err := game.PerftDivide(argDepth)
+			if err != nil {
+				return err
+			}
+			total := uint64(0)
+			for _, division := range divisions {
+				notation := game.RuleSet.FormatPdnMove(rules.PdnMove{
+					Path:    division.Sequence.Path,
+					Capture: len(division.Sequence.Captured) > 0,
+				})
+				if _, err := fmt.Fprintf(out, "%s: %d\n", notation, division.Nodes); err != nil {
+					return err
+				}
+				total += division.Nodes
+			}
+			_, err = fmt.Fprintf(out, "\nTotal: %d\n", total)
+			return err
+		},
+	}
+
+	cmd.Flags().String(flagVariant, rules.ENGLISH, "the rules to play by")
+	cmd.Flags().String(flagBoard, "", "the position to start from, instead of the usual one")
+	cmd.Flags().Bool(flagDivide, false, "print the count below each turn")
+
+	return cmd
+}
+
+// gameFromFlags sets up the game described by the variant and board flags.
+func gameFromFlags(cmd *cobra.Command) (*rules.Game, error) {
+	argVariant, err := cmd.Flags().GetString(flagVariant)
+	if err != nil {
+		return nil, err
+	}
+	argBoard, err := cmd.Flags().GetString(flagBoard)
+	if err != nil {
+		return nil, err
+	}
+	ruleSet, found := rules.GetRuleSet(argVariant)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidVariant, "%s", argVariant)
+	}
+	if argBoard == "" {
+		return rules.NewGame(ruleSet), nil
+	}
+	game, err := rules.ParsePosition(ruleSet, argBoard)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidStartPosition, "%s", err)
+	}
+	return game, nil
+}
@@ -0,0 +1,74 @@
+package cli_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bekauz/checkers/x/checkers/client/cli"
+	"github.com/bekauz/checkers/x/checkers/types"
+)
+
+func TestPerft(t *testing.T) {
+	for _, tc := range []struct {
+		desc  string
+		args  []string
+		nodes string
+		err   error
+	}{
+		{
+			desc:  "start",
+			args:  []string{"5"},
+			nodes: "7361",
+		},
+		{
+			desc:  "FEN",
+			args:  []string{"2", "--board", "W:W18,K31:B1,14"},
+			nodes: "2",
+		},
+		{
+			desc:  "board string",
+			args:  []string{"1", "--board", "*b******|********|********|**b*****|***r****|********|********|****R***"},
+			nodes: "1",
+		},
+		{
+			desc:  "variant",
+			args:  []string{"1", "--variant", "international"},
+			nodes: "9",
+		},
+		{
+			desc: "unknown variant",
+			args: []string{"1", "--variant", "chess"},
+			err:  types.ErrInvalidVariant,
+		},
+		{
+			desc: "invalid board",
+			args: []string{"1", "--board", "W:W33:B1"},
+			err:  types.ErrInvalidStartPosition,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			out, err := clitestutil.ExecTestCLICmd(client.Context{}, cli.CmdPerft(), tc.args)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.nodes+"\n", out.String())
+		})
+	}
+}
+
+func TestPerftDivide(t *testing.T) {
+	out, err := clitestutil.ExecTestCLICmd(client.Context{}, cli.CmdPerft(), []string{"3", "--divide"})
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Len(t, lines, 9)
+	require.Equal(t, "9-13: 48", lines[0])
+	require.Equal(t, "", lines[7])
+	require.Equal(t, fmt.Sprintf("Total: %d", 302), lines[8])
+}
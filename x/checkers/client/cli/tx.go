@@ -32,6 +32,11 @@ func GetTxCmd() *cobra.Command {
 
 	cmd.AddCommand(CmdCreateGame())
 	cmd.AddCommand(CmdPlayMove())
+	cmd.AddCommand(CmdPlayMoves())
+	cmd.AddCommand(CmdResign())
+	cmd.AddCommand(CmdOfferDraw())
+	cmd.AddCommand(CmdAcceptDraw())
+	cmd.AddCommand(CmdDeclineDraw())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
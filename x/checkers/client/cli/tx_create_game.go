@@ -1,25 +1,58 @@
 package cli
 
 import (
+	"fmt"
 	"strconv"
+	"strings"
 
+	"github.com/bekauz/checkers/x/checkers/rules"
 	"github.com/bekauz/checkers/x/checkers/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/spf13/cobra"
 )
 
 var _ = strconv.Itoa(0)
 
+const (
+	flagVariant = "variant"
+	flagBoard   = "board"
+)
+
 func CmdCreateGame() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "create-game [black] [red]",
-		Short: "Broadcast message createGame",
-		Args:  cobra.ExactArgs(2),
+		Use:   "create-game [black] [red] [wager]",
+		Short: "Broadcast message createGame, with an optional wager such as 100stake",
+		Long: fmt.Sprintf("Broadcast message createGame, with an optional wager such as 100stake.\n"+
+			"The --%s flag picks the rules among: %s.\n"+
+			"The --%s flag sets a custom start position, as a FEN such as W:W18,K31:B1,14 or as a board string.",
+			flagVariant, strings.Join(rules.RuleSetNames(), ", "), flagBoard),
+		Args: cobra.RangeArgs(2, 3),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			argBlack := args[0]
 			argRed := args[1]
+			argWager := sdk.Coin{Amount: sdk.ZeroInt()}
+			if len(args) > 2 {
+				argWager, err = sdk.ParseCoinNormalized(args[2])
+				if err != nil {
+					return err
+				}
+				if !argWager.Amount.IsUint64() {
+					return sdkerrors.Wrapf(types.ErrInvalidWager, "too large (%s)", argWager)
+				}
+			}
+
+			argVariant, err := cmd.Flags().GetString(flagVariant)
+			if err != nil {
+				return err
+			}
+			argBoard, err := cmd.Flags().GetString(flagBoard)
+			if err != nil {
+				return err
+			}
 
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -30,6 +63,10 @@ func CmdCreateGame() *cobra.Command {
 				clientCtx.GetFromAddress().String(),
 				argBlack,
 				argRed,
+				argWager.Amount.Uint64(),
+				argWager.Denom,
+				argVariant,
+				argBoard,
 			)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -38,6 +75,8 @@ func CmdCreateGame() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(flagVariant, rules.ENGLISH, "the rules to play by")
+	cmd.Flags().String(flagBoard, "", "the position to start from, instead of the usual one")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
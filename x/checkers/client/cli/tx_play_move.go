@@ -1,12 +1,16 @@
 package cli
 
 import (
+	"context"
+	"fmt"
 	"strconv"
 
+	"github.com/bekauz/checkers/x/checkers/rules"
 	"github.com/bekauz/checkers/x/checkers/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
 )
@@ -17,9 +21,19 @@ func CmdPlayMove() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "play-move [game-index] [from-x] [from-y] [to-x] [to-y]",
 		Short: "Broadcast message playMove",
-		Args:  cobra.ExactArgs(5),
+		Long: "Broadcast message playMove. Instead of zero-based coordinates, the move can be given in the " +
+			"square notation of the game's variant, such as 11-15 for a move or 15x24x31 for captures. " +
+			"A notation with more than one hop is sent as a playMoves message.",
+		Example: "play-move 1 1 2 2 3\nplay-move 1 11-15",
+		Args:    cobra.RangeArgs(2, 5),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			argGameIndex := args[0]
+			if len(args) == 2 {
+				return playMoveNotation(cmd, argGameIndex, args[1])
+			}
+			if len(args) != 5 {
+				return fmt.Errorf("expected coordinates [from-x] [from-y] [to-x] [to-y] or a square notation, got %d arguments", len(args)-1)
+			}
 			argFromX, err := cast.ToUint64E(args[1])
 			if err != nil {
 				return err
@@ -61,3 +75,58 @@ func CmdPlayMove() *cobra.Command {
 
 	return cmd
 }
+
+// playMoveNotation looks up the variant of the game to translate the square
+// notation into positions.
+func playMoveNotation(cmd *cobra.Command, gameIndex string, notation string) error {
+	clientCtx, err := client.GetClientTxContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	res, err := types.NewQueryClient(clientCtx).StoredGame(context.Background(), &types.QueryGetStoredGameRequest{
+		Index: gameIndex,
+	})
+	if err != nil {
+		return err
+	}
+	ruleSet, err := res.StoredGame.GetRuleSet()
+	if err != nil {
+		return err
+	}
+	msg, err := notationMsg(clientCtx.GetFromAddress().String(), gameIndex, ruleSet, notation)
+	if err != nil {
+		return err
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
+
+// notationMsg builds the message that plays the turn written in square
+// notation: a playMove for a single hop, else a playMoves.
+func notationMsg(creator string, gameIndex string, ruleSet *rules.RuleSet, notation string) (msg sdk.Msg, err error) {
+	move, err := ruleSet.ParsePdnMove(notation)
+	if err != nil {
+		return nil, err
+	}
+	if len(move.Path) == 2 {
+		return types.NewMsgPlayMove(
+			creator,
+			gameIndex,
+			uint64(move.Path[0].X),
+			uint64(move.Path[0].Y),
+			uint64(move.Path[1].X),
+			uint64(move.Path[1].Y),
+		), nil
+	}
+	path := make([]types.Position, 0, len(move.Path))
+	for _, pos := range move.Path {
+		path = append(path, types.Position{
+			X: uint64(pos.X),
+			Y: uint64(pos.Y),
+		})
+	}
+	return types.NewMsgPlayMoves(creator, gameIndex, path), nil
+}
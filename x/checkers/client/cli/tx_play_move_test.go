@@ -0,0 +1,59 @@
+package cli
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/bekauz/checkers/x/checkers/rules"
+	"github.com/bekauz/checkers/x/checkers/testutil"
+	"github.com/bekauz/checkers/x/checkers/types"
+	"github.com/stretchr/testify/require"
+)
+
+// TestNotationReplaysExportedPdn plays random games, exports them in PDN and
+// plays each exported move back through the notation of play-move.
+func TestNotationReplaysExportedPdn(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	for _, ruleSet := range []*rules.RuleSet{rules.English, rules.International} {
+		game := rules.NewGame(ruleSet)
+		storedGame := types.StoredGame{Index: "1", Black: testutil.Bob, Red: testutil.Carol, Variant: ruleSet.Name}
+		for ply := 0; ply < 60; ply++ {
+			sequences := game.LegalSequences(game.Turn)
+			if len(sequences) == 0 {
+				break
+			}
+			sequence := sequences[random.Intn(len(sequences))]
+			game.PlaySequence(sequence)
+			storedGame.RecordMove(sequence.Path, len(sequence.Captured) > 0)
+		}
+		pdn, err := storedGame.ExportPdn()
+		require.Nil(t, err)
+
+		replayed := rules.NewGame(ruleSet)
+		movetext := strings.SplitN(pdn.String(), "\n\n", 2)[1]
+		for _, token := range strings.Fields(movetext) {
+			if strings.HasSuffix(token, ".") || token == rules.PDN_ONGOING {
+				continue
+			}
+			msg, err := notationMsg(testutil.Bob, "1", ruleSet, token)
+			require.Nil(t, err, token)
+			var path []rules.Pos
+			switch msg := msg.(type) {
+			case *types.MsgPlayMove:
+				path = []rules.Pos{
+					{X: int(msg.FromX), Y: int(msg.FromY)},
+					{X: int(msg.ToX), Y: int(msg.ToY)},
+				}
+			case *types.MsgPlayMoves:
+				for _, position := range msg.Path {
+					path = append(path, rules.Pos{X: int(position.X), Y: int(position.Y)})
+				}
+			}
+			_, err = replayed.MoveSequence(path)
+			require.Nil(t, err, token)
+		}
+		require.Equal(t, game.String(), replayed.String(), ruleSet.Name)
+		require.Equal(t, game.Turn, replayed.Turn, ruleSet.Name)
+	}
+}
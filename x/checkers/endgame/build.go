@@ -0,0 +1,196 @@
+package endgame
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/bekauz/checkers/x/checkers/rules"
+)
+
+const (
+	// invalidCode marks, while building, the positions that cannot occur
+	invalidCode = math.MaxUint16
+	// maxPlies keeps the encoded results below invalidCode
+	maxPlies = (invalidCode - 3) / 2
+)
+
+// layerBuild gathers what the moves of each position of a layer lead to:
+// the positions of the same layer, reached by moves without capture, and the
+// best and worst results among those of the smaller layers, already solved.
+type layerBuild struct {
+	// children of position i are children[offsets[i]:offsets[i+1]]
+	offsets  []uint32
+	children []uint32
+	// quickestWin is one more than the fewest plies to a lost position of a
+	// smaller layer, or 0
+	quickestWin []uint16
+	// slowestLoss is one more than the most plies to a won position of a
+	// smaller layer, or 0
+	slowestLoss []uint16
+	// drawn tells whether a capture leads to a drawn position
+	drawn []bool
+}
+
+// Build solves every position of the rule set with up to maxPieces pieces,
+// layer by layer since captures only lead to smaller layers. Within a layer,
+// the positions are solved by increasing number of plies: a position is won
+// in n plies when a move leads to a position lost in n-1, and lost in n when
+// every move leads to a position won in at most n-1, the last of them in n-1.
+// Positions left over are draws. The draw limits are not taken into account.
+func Build(ruleSet *rules.RuleSet, maxPieces int) (*Table, error) {
+	if maxPieces < 1 {
+		return nil, errors.New(fmt.Sprintf("invalid number of pieces: %d", maxPieces))
+	}
+	table := newTable(ruleSet, maxPieces)
+	for pieces := 1; pieces <= maxPieces; pieces++ {
+		if math.MaxUint32 < uint64(table.layerSize(pieces)) {
+			return nil, errors.New(fmt.Sprintf("too many positions with %d pieces", pieces))
+		}
+		if err := table.solveLayer(pieces); err != nil {
+			return nil, err
+		}
+	}
+	return table, nil
+}
+
+func (table *Table) solveLayer(pieces int) error {
+	size := table.layerSize(pieces)
+	codes := make([]uint16, size)
+	table.layers[pieces] = codes
+	build, err := table.generateLayer(pieces, codes)
+	if err != nil {
+		return err
+	}
+
+	// the plies that may still resolve a position
+	lastPlies := 0
+	for index := 0; index < size; index++ {
+		if lastPlies < int(build.quickestWin[index]) {
+			lastPlies = int(build.quickestWin[index])
+		}
+		if lastPlies < int(build.slowestLoss[index]) {
+			lastPlies = int(build.slowestLoss[index])
+		}
+	}
+	for plies, changed := 1, true; changed || plies <= lastPlies; plies++ {
+		if maxPlies < plies {
+			return errors.New(fmt.Sprintf("game too long to solve with %d pieces", pieces))
+		}
+		changed = false
+		for index := 0; index < size; index++ {
+			if codes[index] != 0 {
+				continue
+			}
+			if result, found := build.resolve(codes, index, plies); found {
+				codes[index] = encode(result)
+				changed = true
+			}
+		}
+	}
+	for index, code := range codes {
+		if code == invalidCode {
+			codes[index] = 0
+		}
+	}
+	return nil
+}
+
+// generateLayer plays every move of every position of the layer, and records
+// right away the positions where the player to move is stuck.
+func (table *Table) generateLayer(pieces int, codes []uint16) (*layerBuild, error) {
+	size := len(codes)
+	build := &layerBuild{
+		offsets:     make([]uint32, size+1),
+		children:    []uint32{},
+		quickestWin: make([]uint16, size),
+		slowestLoss: make([]uint16, size),
+		drawn:       make([]bool, size),
+	}
+	for index := 0; index < size; index++ {
+		build.offsets[index] = uint32(len(build.children))
+		game, valid := table.position(pieces, index)
+		if !valid {
+			codes[index] = invalidCode
+			continue
+		}
+		sequences := game.LegalSequences(game.Turn)
+		if len(sequences) == 0 {
+			value := LOSS
+			if game.RuleSet.StuckWinner(game.Turn) == game.Turn {
+				value = WIN
+			}
+			codes[index] = encode(Result{Value: value})
+			continue
+		}
+		for _, sequence := range sequences {
+			child := game.Copy()
+			child.PlaySequence(sequence)
+			childIndex, ok := table.index(child)
+			if !ok {
+				return nil, errors.New(fmt.Sprintf("position out of the table: %s", child.Fen()))
+			}
+			if len(child.Pieces) == pieces {
+				if math.MaxUint32 <= uint64(len(build.children)) {
+					return nil, errors.New(fmt.Sprintf("too many moves with %d pieces", pieces))
+				}
+				build.children = append(build.children, uint32(childIndex))
+				continue
+			}
+			build.addSolvedChild(index, decode(table.layers[len(child.Pieces)][childIndex]))
+		}
+	}
+	build.offsets[size] = uint32(len(build.children))
+	return build, nil
+}
+
+func (build *layerBuild) addSolvedChild(index int, child Result) {
+	plies := uint16(child.Plies + 1)
+	switch child.Value {
+	case LOSS:
+		if build.quickestWin[index] == 0 || plies < build.quickestWin[index] {
+			build.quickestWin[index] = plies
+		}
+	case WIN:
+		if build.slowestLoss[index] < plies {
+			build.slowestLoss[index] = plies
+		}
+	default:
+		build.drawn[index] = true
+	}
+}
+
+// resolve tells whether the position is won or lost in exactly plies, given
+// the results of its children found in fewer plies.
+func (build *layerBuild) resolve(codes []uint16, index int, plies int) (result Result, found bool) {
+	if int(build.quickestWin[index]) == plies {
+		return Result{Value: WIN, Plies: plies}, true
+	}
+	canLose := build.quickestWin[index] == 0 && !build.drawn[index]
+	slowest := int(build.slowestLoss[index])
+	for _, child := range build.children[build.offsets[index]:build.offsets[index+1]] {
+		code := codes[child]
+		if code == 0 || code == invalidCode {
+			canLose = false
+			continue
+		}
+		childResult := decode(code)
+		if plies <= childResult.Plies {
+			// solved in this round, too late to count
+			canLose = false
+			continue
+		}
+		if childResult.Value == LOSS && childResult.Plies == plies-1 {
+			return Result{Value: WIN, Plies: plies}, true
+		}
+		if childResult.Value == LOSS {
+			canLose = false
+		} else if slowest < childResult.Plies+1 {
+			slowest = childResult.Plies + 1
+		}
+	}
+	if canLose && slowest == plies {
+		return Result{Value: LOSS, Plies: plies}, true
+	}
+	return Result{}, false
+}
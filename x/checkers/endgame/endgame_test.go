@@ -0,0 +1,154 @@
+package endgame
+
+import (
+	"bytes"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/bekauz/checkers/x/checkers/rules"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	threePieces     *Table
+	threePiecesOnce sync.Once
+)
+
+// englishThreePieces builds the table of English draughts up to 3 pieces
+// once, as it takes a few seconds.
+func englishThreePieces(t *testing.T) *Table {
+	threePiecesOnce.Do(func() {
+		table, err := Build(rules.English, 3)
+		require.Nil(t, err)
+		threePieces = table
+	})
+	require.NotNil(t, threePieces)
+	return threePieces
+}
+
+func probe(t *testing.T, table *Table, fen string) Result {
+	game, err := rules.ParseFen(table.RuleSet, fen)
+	require.Nil(t, err)
+	result, found := table.Probe(game)
+	require.True(t, found, fen)
+	return result
+}
+
+func TestIndexRoundTrip(t *testing.T) {
+	table := newTable(rules.English, 3)
+	for pieces := 1; pieces <= 3; pieces++ {
+		for index := 0; index < table.layerSize(pieces); index += 97 {
+			game, _ := table.position(pieces, index)
+			roundTrip, ok := table.index(game)
+			require.True(t, ok)
+			require.Equal(t, index, roundTrip, game.Fen())
+		}
+	}
+}
+
+func TestBuildTwoPieces(t *testing.T) {
+	table, err := Build(rules.English, 2)
+	require.Nil(t, err)
+
+	require.Equal(t, Result{Value: LOSS}, probe(t, table, "W:W:B1"))
+	require.Equal(t, Result{Value: WIN, Plies: 1}, probe(t, table, "B:W10:B6"))
+	require.Equal(t, Result{Value: WIN, Plies: 13}, probe(t, table, "B:W30:B25"))
+	require.Equal(t, DRAW, probe(t, table, "B:WK32:BK1").Value)
+
+	_, found := table.Probe(rules.New())
+	require.False(t, found)
+	_, found = table.Probe(rules.NewGame(rules.Giveaway))
+	require.False(t, found)
+}
+
+func TestTwoKingsBeatOne(t *testing.T) {
+	table := englishThreePieces(t)
+
+	require.Equal(t, Result{Value: WIN, Plies: 31}, probe(t, table, "B:WK32:BK1,K5"))
+	require.Equal(t, Result{Value: LOSS, Plies: 32}, probe(t, table, "W:WK32:BK1,K5"))
+}
+
+// TestResultsAgreeWithMoves checks every position against the results of the
+// positions its moves lead to.
+func TestResultsAgreeWithMoves(t *testing.T) {
+	table := englishThreePieces(t)
+	for pieces := 1; pieces <= 3; pieces++ {
+		for index := 0; index < table.layerSize(pieces); index += 7 {
+			game, valid := table.position(pieces, index)
+			if !valid {
+				continue
+			}
+			result, found := table.Probe(game)
+			require.True(t, found)
+			sequences := game.LegalSequences(game.Turn)
+			if len(sequences) == 0 {
+				require.Equal(t, 0, result.Plies, game.Fen())
+				continue
+			}
+			quickestWin, slowestLoss, drawn := -1, -1, false
+			for _, sequence := range sequences {
+				child := game.Copy()
+				child.PlaySequence(sequence)
+				childResult, found := table.Probe(child)
+				require.True(t, found)
+				switch childResult.Value {
+				case LOSS:
+					if quickestWin < 0 || childResult.Plies+1 < quickestWin {
+						quickestWin = childResult.Plies + 1
+					}
+				case WIN:
+					if slowestLoss < childResult.Plies+1 {
+						slowestLoss = childResult.Plies + 1
+					}
+				default:
+					drawn = true
+				}
+			}
+			switch {
+			case 0 <= quickestWin:
+				require.Equal(t, Result{Value: WIN, Plies: quickestWin}, result, game.Fen())
+			case drawn:
+				require.Equal(t, Result{Value: DRAW}, result, game.Fen())
+			default:
+				require.Equal(t, Result{Value: LOSS, Plies: slowestLoss}, result, game.Fen())
+			}
+		}
+	}
+}
+
+func TestGiveawayTable(t *testing.T) {
+	table, err := Build(rules.Giveaway, 2)
+	require.Nil(t, err)
+
+	require.Equal(t, Result{Value: WIN}, probe(t, table, "W:W:B1"))
+	require.Equal(t, Result{Value: LOSS, Plies: 1}, probe(t, table, "B:W10:B6"))
+}
+
+func TestWriteAndReadFile(t *testing.T) {
+	table, err := Build(rules.English, 2)
+	require.Nil(t, err)
+	path := filepath.Join(t.TempDir(), "english.egtb")
+	require.Nil(t, table.WriteFile(path))
+
+	read, err := ReadFile(path)
+	require.Nil(t, err)
+	require.Equal(t, table.RuleSet, read.RuleSet)
+	require.Equal(t, table.MaxPieces, read.MaxPieces)
+	require.Equal(t, table.layers, read.layers)
+	require.Equal(t, Result{Value: WIN, Plies: 13}, probe(t, read, "B:W30:B25"))
+}
+
+func TestReadInvalidFile(t *testing.T) {
+	_, err := Read(bytes.NewReader([]byte("not a table")))
+	require.NotNil(t, err)
+
+	table, err := Build(rules.English, 1)
+	require.Nil(t, err)
+	var buf bytes.Buffer
+	require.Nil(t, table.Write(&buf))
+	_, err = Read(bytes.NewReader(buf.Bytes()[:buf.Len()-10]))
+	require.NotNil(t, err)
+	_, err = Build(rules.English, 0)
+	require.NotNil(t, err)
+}
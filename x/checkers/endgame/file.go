@@ -0,0 +1,113 @@
+package endgame
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+
+	"github.com/bekauz/checkers/x/checkers/rules"
+)
+
+// fileMagic starts every table file, and changes with its layout. The file is
+// gzipped and holds, in little endian: the magic, the length and the name of
+// the rule set, the number of pieces, then the codes of each layer in order.
+const fileMagic = "CHKEG001"
+
+// WriteFile saves the table to a local file.
+func (table *Table) WriteFile(path string) (err error) {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := file.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+	return table.Write(file)
+}
+
+func (table *Table) Write(w io.Writer) error {
+	zipped := gzip.NewWriter(w)
+	buffered := bufio.NewWriter(zipped)
+	header := []interface{}{
+		[]byte(fileMagic),
+		uint16(len(table.RuleSet.Name)),
+		[]byte(table.RuleSet.Name),
+		uint8(table.MaxPieces),
+	}
+	for _, field := range header {
+		if err := binary.Write(buffered, binary.LittleEndian, field); err != nil {
+			return err
+		}
+	}
+	for pieces := 1; pieces <= table.MaxPieces; pieces++ {
+		if err := binary.Write(buffered, binary.LittleEndian, table.layers[pieces]); err != nil {
+			return err
+		}
+	}
+	if err := buffered.Flush(); err != nil {
+		return err
+	}
+	return zipped.Close()
+}
+
+// ReadFile loads a table saved by WriteFile.
+func ReadFile(path string) (*Table, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return Read(file)
+}
+
+func Read(r io.Reader) (*Table, error) {
+	zipped, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("invalid endgame table: %v", err))
+	}
+	defer zipped.Close()
+	buffered := bufio.NewReader(zipped)
+
+	magic := make([]byte, len(fileMagic))
+	if _, err := io.ReadFull(buffered, magic); err != nil || string(magic) != fileMagic {
+		return nil, errors.New("invalid endgame table: not a table file")
+	}
+	var nameLength uint16
+	if err := binary.Read(buffered, binary.LittleEndian, &nameLength); err != nil {
+		return nil, errors.New(fmt.Sprintf("invalid endgame table: %v", err))
+	}
+	name := make([]byte, nameLength)
+	if _, err := io.ReadFull(buffered, name); err != nil {
+		return nil, errors.New(fmt.Sprintf("invalid endgame table: %v", err))
+	}
+	ruleSet, found := rules.GetRuleSet(string(name))
+	if !found {
+		return nil, errors.New(fmt.Sprintf("invalid endgame table: unknown variant %s", name))
+	}
+	var maxPieces uint8
+	if err := binary.Read(buffered, binary.LittleEndian, &maxPieces); err != nil || maxPieces < 1 {
+		return nil, errors.New("invalid endgame table: invalid number of pieces")
+	}
+	table := newTable(ruleSet, int(maxPieces))
+	for pieces := 1; pieces <= table.MaxPieces; pieces++ {
+		if math.MaxUint32 < uint64(table.layerSize(pieces)) {
+			return nil, errors.New("invalid endgame table: too many pieces")
+		}
+		layer := make([]uint16, table.layerSize(pieces))
+		if err := binary.Read(buffered, binary.LittleEndian, layer); err != nil {
+			return nil, errors.New(fmt.Sprintf("invalid endgame table: layer %d: %v", pieces, err))
+		}
+		table.layers[pieces] = layer
+	}
+	if _, err := buffered.ReadByte(); err != io.EOF {
+		return nil, errors.New("invalid endgame table: trailing data")
+	}
+	return table, nil
+}
@@ -0,0 +1,198 @@
+package endgame
+
+import (
+	"github.com/bekauz/checkers/x/checkers/rules"
+)
+
+// Value is the outcome of a position with best play, for the player to move.
+type Value uint8
+
+const (
+	DRAW Value = iota
+	WIN
+	LOSS
+)
+
+var valueNames = map[Value]string{
+	DRAW: "draw",
+	WIN:  "win",
+	LOSS: "loss",
+}
+
+func (value Value) String() string {
+	return valueNames[value]
+}
+
+// Result is the value of a position and, unless it is a draw, the number of
+// turns left until the game ends: as few as possible for the winner, as many
+// as possible for the loser.
+type Result struct {
+	Value Value
+	Plies int
+}
+
+// Table holds the results of every position of a rule set with at most
+// MaxPieces pieces, draw limits aside.
+//
+// The positions with k pieces form a layer, in which a position is numbered
+// after the player to move, the rank of the set of squares taken, and two bits
+// for each piece in square order: red and king.
+type Table struct {
+	RuleSet   *rules.RuleSet
+	MaxPieces int
+	// layers holds the encoded results by number of pieces, see encode
+	layers [][]uint16
+	// squares lists the usable squares by square number less one
+	squares []rules.Pos
+	// binomials[n][k] is n choose k
+	binomials [][]int
+}
+
+func newTable(ruleSet *rules.RuleSet, maxPieces int) *Table {
+	squares := []rules.Pos{}
+	for square := 1; ; square++ {
+		pos, found := ruleSet.SquarePos(square)
+		if !found {
+			break
+		}
+		squares = append(squares, pos)
+	}
+	binomials := make([][]int, len(squares)+1)
+	for n := range binomials {
+		binomials[n] = make([]int, maxPieces+1)
+		binomials[n][0] = 1
+		for k := 1; k <= maxPieces && 0 < n; k++ {
+			binomials[n][k] = binomials[n-1][k-1] + binomials[n-1][k]
+		}
+	}
+	return &Table{
+		RuleSet:   ruleSet,
+		MaxPieces: maxPieces,
+		layers:    make([][]uint16, maxPieces+1),
+		squares:   squares,
+		binomials: binomials,
+	}
+}
+
+// layerSize is the number of positions with that many pieces.
+func (table *Table) layerSize(pieces int) int {
+	return 2 * table.binomials[len(table.squares)][pieces] << (2 * pieces)
+}
+
+// Probe looks up the position of the game. It is not found when the game has
+// too many pieces or follows other rules.
+func (table *Table) Probe(game *rules.Game) (result Result, found bool) {
+	pieces := len(game.Pieces)
+	if game.RuleSet != table.RuleSet || pieces == 0 || table.MaxPieces < pieces {
+		return Result{}, false
+	}
+	index, ok := table.index(game)
+	if !ok {
+		return Result{}, false
+	}
+	return decode(table.layers[pieces][index]), true
+}
+
+// index numbers the position of the game within its layer.
+func (table *Table) index(game *rules.Game) (index int, ok bool) {
+	type square struct {
+		number int
+		piece  rules.Piece
+	}
+	squares := make([]square, 0, len(game.Pieces))
+	for pos, piece := range game.Pieces {
+		number, found := table.RuleSet.Square(pos)
+		if !found {
+			return 0, false
+		}
+		squares = append(squares, square{number - 1, piece})
+	}
+	// few pieces, sorted by insertion
+	for i := 1; i < len(squares); i++ {
+		for j := i; 0 < j && squares[j].number < squares[j-1].number; j-- {
+			squares[j], squares[j-1] = squares[j-1], squares[j]
+		}
+	}
+	rank, kinds := 0, 0
+	for i, square := range squares {
+		rank += table.binomials[square.number][i+1]
+		kinds |= pieceKind(square.piece) << (2 * i)
+	}
+	turn := 0
+	if game.Turn == rules.RED_PLAYER {
+		turn = 1
+	}
+	combinations := table.binomials[len(table.squares)][len(squares)]
+	return (turn*combinations+rank)<<(2*len(squares)) | kinds, true
+}
+
+// position sets up the position numbered index among those with that many
+// pieces, and tells whether it can occur: a man cannot stand on the row where
+// it would have been promoted.
+func (table *Table) position(pieces int, index int) (game *rules.Game, valid bool) {
+	kinds := index & (1<<(2*pieces) - 1)
+	index >>= 2 * pieces
+	combinations := table.binomials[len(table.squares)][pieces]
+	game = &rules.Game{Pieces: make(map[rules.Pos]rules.Piece, pieces), Turn: rules.BLACK_PLAYER, RuleSet: table.RuleSet}
+	if combinations <= index {
+		game.Turn = rules.RED_PLAYER
+	}
+	rank := index % combinations
+	valid = true
+	for i := pieces - 1; 0 <= i; i-- {
+		// the largest square whose binomial fits in the rank
+		number := i
+		for table.binomials[number+1][i+1] <= rank {
+			number++
+		}
+		rank -= table.binomials[number][i+1]
+		piece := kindPiece(kinds >> (2 * i) & 3)
+		pos := table.squares[number]
+		if !piece.King && table.RuleSet.Promotes(piece.Player, pos) {
+			valid = false
+		}
+		game.Pieces[pos] = piece
+	}
+	return game, valid
+}
+
+func pieceKind(piece rules.Piece) int {
+	kind := 0
+	if piece.Player == rules.RED_PLAYER {
+		kind |= 1
+	}
+	if piece.King {
+		kind |= 2
+	}
+	return kind
+}
+
+func kindPiece(kind int) rules.Piece {
+	player := rules.BLACK_PLAYER
+	if kind&1 != 0 {
+		player = rules.RED_PLAYER
+	}
+	return rules.Piece{Player: player, King: kind&2 != 0}
+}
+
+// encode packs a result as 0 for a draw, 2*plies+1 for a win and 2*plies+2
+// for a loss.
+func encode(result Result) uint16 {
+	switch result.Value {
+	case WIN:
+		return uint16(2*result.Plies + 1)
+	case LOSS:
+		return uint16(2*result.Plies + 2)
+	}
+	return 0
+}
+
+func decode(code uint16) Result {
+	if code == 0 {
+		return Result{Value: DRAW}
+	}
+	if code%2 == 1 {
+		return Result{Value: WIN, Plies: int(code-1) / 2}
+	}
+	return Result{Value: LOSS, Plies: int(code-2) / 2}
+}
@@ -0,0 +1,277 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/bekauz/checkers/x/checkers/endgame"
+	"github.com/bekauz/checkers/x/checkers/rules"
+)
+
+const (
+	// WinScore is the score of a won position, less the plies it takes to
+	// reach it so that quicker wins are preferred
+	WinScore = 1_000_000
+	// DefaultDepth bounds a search that was given no limit at all
+	DefaultDepth = 8
+
+	maxDepth = 64
+	// maxWinPlies bounds the plies to a win, which endgame tables may find
+	// well beyond the search depth
+	maxWinPlies = 1 << 16
+	// timeCheckNodes is how often, in nodes, the clock is looked at
+	timeCheckNodes = 1024
+)
+
+var ErrNoMove = errors.New("no legal move")
+
+// Limits bound a search. A zero field sets no limit. The depth counts whole
+// turns, a capture sequence being a single ply.
+type Limits struct {
+	Depth int
+	Nodes uint64
+	Time  time.Duration
+}
+
+// Result is the outcome of the deepest search iteration that completed. At a
+// fixed depth, the best move and the score do not depend on the number of
+// workers, while the nodes do.
+type Result struct {
+	Best rules.Sequence
+	// Score is for the player to move, see WinScore for won positions
+	Score int
+	Depth int
+	Nodes uint64
+}
+
+// IsWin tells whether a score means that the game is won, or lost when
+// negative, within the search horizon or according to the endgame table.
+func IsWin(score int) bool {
+	return WinScore-maxWinPlies <= score || score <= -WinScore+maxWinPlies
+}
+
+// Engine searches for the best turn to play with alpha-beta pruning and
+// iterative deepening. Its table, if any, is kept from one search to the next.
+type Engine struct {
+	Weights Weights
+	Table   *TranspositionTable
+	// Workers is how many goroutines share the moves of the root, and the
+	// table, in each iteration. Zero means one.
+	Workers int
+	// Endgame, if any, gives the exact score of the positions with few
+	// enough pieces instead of searching them
+	Endgame *endgame.Table
+}
+
+func New() *Engine {
+	return &Engine{Weights: DefaultWeights, Table: NewTranspositionTable(DefaultTableSize), Workers: 1}
+}
+
+// search holds the state of a single call to Search, shared by its workers.
+type search struct {
+	engine   *Engine
+	limits   Limits
+	deadline time.Time
+	nodes    atomic.Uint64
+	aborted  atomic.Bool
+}
+
+// Search looks deeper and deeper into the game until a limit is reached, and
+// returns the best turn found by the last iteration that completed. The first
+// iteration always completes so that a move is returned. The game is not
+// modified.
+func (engine *Engine) Search(game *rules.Game, limits Limits) (result Result, err error) {
+	moves := game.LegalSequences(game.Turn)
+	if len(moves) == 0 || game.Winner() != rules.NO_PLAYER {
+		return Result{}, ErrNoMove
+	}
+	depthLimit := limits.Depth
+	if depthLimit <= 0 || maxDepth < depthLimit {
+		depthLimit = maxDepth
+	}
+	if limits.Depth <= 0 && limits.Nodes == 0 && limits.Time == 0 {
+		depthLimit = DefaultDepth
+	}
+	// hashing the root once lets every position below update its hash
+	game = game.Copy()
+	game.Hash()
+	state := &search{engine: engine, limits: limits}
+	if limits.Time != 0 {
+		state.deadline = time.Now().Add(limits.Time)
+	}
+	for depth := 1; depth <= depthLimit; depth++ {
+		best, score := state.root(game, moves, depth)
+		if state.aborted.Load() && depth > 1 {
+			break
+		}
+		result = Result{Best: moves[best], Score: score, Depth: depth}
+		// try the best move first in the next iteration
+		moves = append([]rules.Sequence{moves[best]}, append(moves[:best:best], moves[best+1:]...)...)
+		if state.aborted.Load() || IsWin(score) {
+			break
+		}
+	}
+	result.Nodes = state.nodes.Load()
+	return result, nil
+}
+
+// root has the workers search the moves of the player to move, and returns
+// the index of the first of the best ones. Each move is searched with a window
+// starting just below the best score found so far, so that a move as good as
+// the best one gets its exact score whichever worker searched it first. In the
+// first iteration, the workers do not abort until all moves have been searched.
+func (state *search) root(game *rules.Game, moves []rules.Sequence, depth int) (best int, bestScore int) {
+	scores := make([]int, len(moves))
+	indices := make(chan int, len(moves))
+	for i := range moves {
+		indices <- i
+	}
+	close(indices)
+	var mutex sync.Mutex
+	alpha := -WinScore - 1
+	var workers sync.WaitGroup
+	for worker := 0; worker < state.engine.Workers || worker == 0; worker++ {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			for i := range indices {
+				if state.aborted.Load() && depth > 1 {
+					return
+				}
+				mutex.Lock()
+				floor := alpha - 1
+				mutex.Unlock()
+				score := -state.alphaBeta(play(game, moves[i]), depth-1, 1, -WinScore-1, -floor)
+				mutex.Lock()
+				scores[i] = score
+				if alpha < score {
+					alpha = score
+				}
+				mutex.Unlock()
+			}
+		}()
+	}
+	workers.Wait()
+	bestScore = -WinScore - 1
+	for i, score := range scores {
+		if bestScore < score {
+			best, bestScore = i, score
+		}
+	}
+	return best, bestScore
+}
+
+// alphaBeta scores the game for its player to move, looking depth plies
+// ahead. The score is exact when it falls strictly between alpha and beta.
+func (state *search) alphaBeta(game *rules.Game, depth int, ply int, alpha int, beta int) int {
+	if state.outOfBudget(state.nodes.Add(1)) {
+		state.aborted.Store(true)
+	}
+	moves := game.LegalSequences(game.Turn)
+	if len(moves) == 0 {
+		if game.RuleSet.StuckWinner(game.Turn) == game.Turn {
+			return WinScore - ply
+		}
+		return -WinScore + ply
+	}
+	if game.IsDraw() {
+		return 0
+	}
+	if state.engine.Endgame != nil {
+		if result, found := state.engine.Endgame.Probe(game); found {
+			return endgameScore(result, ply)
+		}
+	}
+	if depth <= 0 || state.aborted.Load() {
+		return state.engine.Weights.Evaluate(game)
+	}
+	table := state.engine.Table
+	firstMove := 0
+	originalAlpha := alpha
+	if table != nil {
+		if entry, found := table.Probe(game.Hash()); found {
+			// a deeper entry would make the score depend on which positions
+			// were searched before
+			if depth == entry.Depth {
+				score := fromTable(entry.Score, ply)
+				switch {
+				case entry.Bound == EXACT:
+					return score
+				case entry.Bound == LOWER_BOUND && alpha < score:
+					alpha = score
+				case entry.Bound == UPPER_BOUND && score < beta:
+					beta = score
+				}
+				if beta <= alpha {
+					return score
+				}
+			}
+			if entry.Best < len(moves) {
+				firstMove = entry.Best
+			}
+		}
+	}
+	best, bestMove := -WinScore-1, firstMove
+	for _, index := range moveOrder(len(moves), firstMove) {
+		score := -state.alphaBeta(play(game, moves[index]), depth-1, ply+1, -beta, -alpha)
+		if best < score {
+			best, bestMove = score, index
+		}
+		if alpha < score {
+			alpha = score
+		}
+		if beta <= alpha || state.aborted.Load() {
+			break
+		}
+	}
+	if table != nil && !state.aborted.Load() {
+		bound := EXACT
+		if best <= originalAlpha {
+			bound = UPPER_BOUND
+		} else if beta <= best {
+			bound = LOWER_BOUND
+		}
+		table.Store(Entry{Hash: game.Hash(), Depth: depth, Score: toTable(best, ply), Bound: bound, Best: bestMove})
+	}
+	return best
+}
+
+// moveOrder lists the indices of the moves, first first and the others in
+// their order.
+func moveOrder(count int, first int) []int {
+	order := make([]int, 0, count)
+	order = append(order, first)
+	for index := 0; index < count; index++ {
+		if index != first {
+			order = append(order, index)
+		}
+	}
+	return order
+}
+
+func (state *search) outOfBudget(nodes uint64) bool {
+	if state.limits.Nodes != 0 && state.limits.Nodes <= nodes {
+		return true
+	}
+	return !state.deadline.IsZero() && nodes%timeCheckNodes == 0 && time.Now().After(state.deadline)
+}
+
+// endgameScore scores a result of the endgame table found at ply, as a search
+// finding the end of the game would.
+func endgameScore(result endgame.Result, ply int) int {
+	switch result.Value {
+	case endgame.WIN:
+		return WinScore - ply - result.Plies
+	case endgame.LOSS:
+		return -WinScore + ply + result.Plies
+	}
+	return 0
+}
+
+func play(game *rules.Game, move rules.Sequence) *rules.Game {
+	child := game.Copy()
+	child.PlaySequence(move)
+	return child
+}
@@ -0,0 +1,175 @@
+package engine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bekauz/checkers/x/checkers/endgame"
+	"github.com/bekauz/checkers/x/checkers/rules"
+	"github.com/stretchr/testify/require"
+)
+
+const boardWithPieceToKeep = "********|********|********|**b*****|********|****r***|********|********"
+
+func TestEvaluateStartIsEven(t *testing.T) {
+	require.Equal(t, 0, DefaultWeights.Evaluate(rules.New()))
+}
+
+func TestEvaluateCountsMaterial(t *testing.T) {
+	game, err := rules.ParseFen(rules.English, "B:W18:B1,K2")
+	require.Nil(t, err)
+	require.Less(t, 0, DefaultWeights.Evaluate(game))
+	game.Turn = rules.RED_PLAYER
+	require.Greater(t, 0, DefaultWeights.Evaluate(game))
+}
+
+func TestSearchFindsWinningCapture(t *testing.T) {
+	game, err := rules.ParseFen(rules.English, "B:W10:B6")
+	require.Nil(t, err)
+
+	result, err := New().Search(game, Limits{Depth: 4})
+	require.Nil(t, err)
+	require.Equal(t, []rules.Pos{{X: 2, Y: 1}, {X: 4, Y: 3}}, result.Best.Path)
+	require.Equal(t, WinScore-1, result.Score)
+	require.True(t, IsWin(result.Score))
+}
+
+func TestSearchKeepsPiece(t *testing.T) {
+	game, err := rules.Parse(boardWithPieceToKeep)
+	require.Nil(t, err)
+
+	result, err := New().Search(game, Limits{Depth: 3})
+	require.Nil(t, err)
+	require.Equal(t, []rules.Pos{{X: 2, Y: 3}, {X: 1, Y: 4}}, result.Best.Path)
+	require.Equal(t, 3, result.Depth)
+}
+
+func TestSearchGivesPieceAwayInGiveaway(t *testing.T) {
+	game, err := rules.ParseWithRuleSet(rules.Giveaway, boardWithPieceToKeep)
+	require.Nil(t, err)
+
+	result, err := New().Search(game, Limits{Depth: 3})
+	require.Nil(t, err)
+	require.Equal(t, []rules.Pos{{X: 2, Y: 3}, {X: 3, Y: 4}}, result.Best.Path)
+}
+
+func TestSearchDoesNotModifyGame(t *testing.T) {
+	game := rules.New()
+	before := game.String()
+
+	_, err := New().Search(game, Limits{Depth: 3})
+	require.Nil(t, err)
+	require.Equal(t, before, game.String())
+	require.Equal(t, rules.BLACK_PLAYER, game.Turn)
+}
+
+func TestSearchIsDeterministic(t *testing.T) {
+	first, err := New().Search(rules.New(), Limits{Depth: 5})
+	require.Nil(t, err)
+	second, err := New().Search(rules.New(), Limits{Depth: 5})
+	require.Nil(t, err)
+	require.Equal(t, first, second)
+}
+
+func TestSearchRespectsNodeBudget(t *testing.T) {
+	result, err := New().Search(rules.New(), Limits{Nodes: 500})
+	require.Nil(t, err)
+	require.LessOrEqual(t, 1, result.Depth)
+	require.Less(t, result.Nodes, uint64(500+maxDepth))
+	require.Len(t, result.Best.Path, 2)
+}
+
+func TestSearchRespectsTimeBudget(t *testing.T) {
+	start := time.Now()
+	result, err := New().Search(rules.New(), Limits{Time: 50 * time.Millisecond})
+	require.Nil(t, err)
+	require.Less(t, time.Since(start), time.Second)
+	require.LessOrEqual(t, 1, result.Depth)
+}
+
+func TestSearchNoMoveWhenGameOver(t *testing.T) {
+	game, err := rules.ParseFen(rules.English, "B:W18:B")
+	require.Nil(t, err)
+
+	_, err = New().Search(game, Limits{Depth: 2})
+	require.ErrorIs(t, err, ErrNoMove)
+}
+
+func TestTableKeepsScoreAndSavesNodes(t *testing.T) {
+	withoutTable := New()
+	withoutTable.Table = nil
+	expected, err := withoutTable.Search(rules.New(), Limits{Depth: 6})
+	require.Nil(t, err)
+
+	result, err := New().Search(rules.New(), Limits{Depth: 6})
+	require.Nil(t, err)
+	require.Equal(t, expected.Score, result.Score)
+	require.Less(t, result.Nodes, expected.Nodes)
+}
+
+func TestTableStoresWinsFromTheirPosition(t *testing.T) {
+	table := NewTranspositionTable(1000)
+	table.Store(Entry{Hash: 1029, Depth: 3, Score: toTable(WinScore-5, 2), Bound: EXACT, Best: 1})
+
+	entry, found := table.Probe(1029)
+	require.True(t, found)
+	require.Equal(t, WinScore-3, entry.Score)
+	require.Equal(t, WinScore-7, fromTable(entry.Score, 4))
+	_, found = table.Probe(5)
+	require.False(t, found)
+	table.Clear()
+	_, found = table.Probe(1029)
+	require.False(t, found)
+}
+
+func TestParallelSearchMatchesSingleWorker(t *testing.T) {
+	for _, fen := range []string{
+		rules.New().Fen(),
+		"W:W18,19,21,23,24,26,29,30,31,32:B1,2,3,5,6,7,9,10,12,14",
+		"B:WK3,20,24,28:B5,K18,19",
+	} {
+		game, err := rules.ParseFen(rules.English, fen)
+		require.Nil(t, err)
+		expected, err := New().Search(game, Limits{Depth: 6})
+		require.Nil(t, err)
+
+		for _, workers := range []int{2, 4, 8} {
+			engine := New()
+			engine.Workers = workers
+			for round := 0; round < 3; round++ {
+				result, err := engine.Search(game, Limits{Depth: 6})
+				require.Nil(t, err)
+				require.Equal(t, expected.Best, result.Best, fen)
+				require.Equal(t, expected.Score, result.Score, fen)
+				require.Equal(t, 6, result.Depth)
+			}
+		}
+	}
+}
+
+func TestParallelSearchRespectsNodeBudget(t *testing.T) {
+	engine := New()
+	engine.Workers = 4
+	result, err := engine.Search(rules.New(), Limits{Nodes: 2000})
+	require.Nil(t, err)
+	require.LessOrEqual(t, 1, result.Depth)
+	require.Less(t, result.Nodes, uint64(2000+4*maxDepth))
+}
+
+func TestSearchUsesEndgameTable(t *testing.T) {
+	game, err := rules.ParseFen(rules.English, "B:W30:B25")
+	require.Nil(t, err)
+	result, err := New().Search(game, Limits{Depth: 2})
+	require.Nil(t, err)
+	require.False(t, IsWin(result.Score))
+
+	table, err := endgame.Build(rules.English, 2)
+	require.Nil(t, err)
+	engine := New()
+	engine.Endgame = table
+	result, err = engine.Search(game, Limits{Depth: 2})
+	require.Nil(t, err)
+	require.Equal(t, WinScore-13, result.Score)
+	require.True(t, IsWin(result.Score))
+	require.Equal(t, 1, result.Depth)
+}
@@ -0,0 +1,73 @@
+package engine
+
+import (
+	"math/bits"
+
+	"github.com/bekauz/checkers/x/checkers/rules"
+)
+
+// Weights are what each feature of a position is worth, in hundredths of a
+// man.
+type Weights struct {
+	Man  int
+	King int
+	// Advancement is earned by a man for each row it has moved towards its
+	// promotion row
+	Advancement int
+	// Mobility is earned for each piece that can move
+	Mobility int
+}
+
+var DefaultWeights = Weights{
+	Man:         100,
+	King:        160,
+	Advancement: 3,
+	Mobility:    4,
+}
+
+// Evaluate scores the position for the player to move, positive when they
+// stand better. It does not look at whether the game is over. In a variant
+// where the stuck player wins, having less is better, so the score is negated.
+func (weights Weights) Evaluate(game *rules.Game) int {
+	score := 0
+	for pos, piece := range game.Pieces {
+		value := 0
+		if piece.King {
+			value = weights.King
+		} else {
+			value = weights.Man + weights.Advancement*advancement(game.RuleSet, piece.Player, pos)
+		}
+		if piece.Player == game.Turn {
+			score += value
+		} else {
+			score -= value
+		}
+	}
+	score += weights.Mobility * (mobility(game, game.Turn) - mobility(game, rules.Opponents[game.Turn]))
+	if game.RuleSet.EndCondition == rules.STUCK_PLAYER_WINS {
+		return -score
+	}
+	return score
+}
+
+// advancement counts the rows a man has left behind since its own edge.
+func advancement(ruleSet *rules.RuleSet, player rules.Player, pos rules.Pos) int {
+	if player == rules.BLACK_PLAYER {
+		return pos.Y
+	}
+	return ruleSet.BoardDim - 1 - pos.Y
+}
+
+// mobility counts the pieces of the player that can move or capture.
+func mobility(game *rules.Game, player rules.Player) int {
+	if game.RuleSet.Bitboards {
+		if bitboard, err := rules.NewBitboard(game.Pieces); err == nil {
+			return bits.OnesCount32(bitboard.Movers(player) | bitboard.Jumpers(player))
+		}
+	}
+	movers := map[rules.Pos]bool{}
+	for _, move := range game.LegalMoves(player) {
+		movers[move.Src] = true
+	}
+	return len(movers)
+}
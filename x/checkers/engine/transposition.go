@@ -0,0 +1,117 @@
+package engine
+
+import "sync"
+
+const (
+	// DefaultTableSize is the number of entries of the table of a new engine.
+	DefaultTableSize = 1 << 18
+
+	// tableShards is how many locks share the slots of a table
+	tableShards = 64
+)
+
+type Bound uint8
+
+const (
+	EXACT Bound = iota
+	// LOWER_BOUND is stored when the search failed high, the score being at
+	// least the one recorded
+	LOWER_BOUND
+	// UPPER_BOUND is stored when no move reached alpha, the score being at
+	// most the one recorded
+	UPPER_BOUND
+)
+
+// Entry records what a search found about the position with that hash. Best
+// is the index of the best move in the legal sequences of the position.
+type Entry struct {
+	Hash  uint64
+	Depth int
+	Score int
+	Bound Bound
+	Best  int
+}
+
+// TranspositionTable remembers searched positions by their Zobrist hash, so
+// that a position reached again through other moves is not searched again.
+// Each hash has a single slot, where the latest entry replaces the previous.
+// It is safe for concurrent use, the slots being locked by shards.
+type TranspositionTable struct {
+	entries []Entry
+	used    []bool
+	mask    uint64
+	locks   [tableShards]sync.Mutex
+}
+
+// NewTranspositionTable makes a table with size entries, rounded down to a
+// power of two.
+func NewTranspositionTable(size int) *TranspositionTable {
+	slots := 1
+	for slots*2 <= size {
+		slots *= 2
+	}
+	return &TranspositionTable{
+		entries: make([]Entry, slots),
+		used:    make([]bool, slots),
+		mask:    uint64(slots - 1),
+	}
+}
+
+func (table *TranspositionTable) Probe(hash uint64) (entry Entry, found bool) {
+	slot := hash & table.mask
+	lock := table.lock(slot)
+	lock.Lock()
+	defer lock.Unlock()
+	if !table.used[slot] || table.entries[slot].Hash != hash {
+		return Entry{}, false
+	}
+	return table.entries[slot], true
+}
+
+func (table *TranspositionTable) Store(entry Entry) {
+	slot := entry.Hash & table.mask
+	lock := table.lock(slot)
+	lock.Lock()
+	defer lock.Unlock()
+	table.entries[slot] = entry
+	table.used[slot] = true
+}
+
+// Clear forgets every entry, as when starting an unrelated game.
+func (table *TranspositionTable) Clear() {
+	for shard := range table.locks {
+		table.locks[shard].Lock()
+	}
+	for slot := range table.used {
+		table.used[slot] = false
+	}
+	for shard := range table.locks {
+		table.locks[shard].Unlock()
+	}
+}
+
+func (table *TranspositionTable) lock(slot uint64) *sync.Mutex {
+	return &table.locks[slot%tableShards]
+}
+
+// toTable makes a win score relative to the position being stored rather
+// than to the root, since the position may be found again at another ply.
+func toTable(score int, ply int) int {
+	if WinScore-maxWinPlies <= score {
+		return score + ply
+	}
+	if score <= -WinScore+maxWinPlies {
+		return score - ply
+	}
+	return score
+}
+
+func fromTable(score int, ply int) int {
+	if WinScore-maxWinPlies <= score {
+		return score - ply
+	}
+	if score <= -WinScore+maxWinPlies {
+		return score + ply
+	}
+	return score
+}
@@ -12,8 +12,16 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 
 	// Set all the storedGame
 	for _, elem := range genState.StoredGameList {
+		// boards may be given as FEN
+		if err := elem.NormalizeBoard(); err != nil {
+			panic(err)
+		}
 		k.SetStoredGame(ctx, elem)
 	}
+	// Set all the playerInfo
+	for _, elem := range genState.PlayerInfoList {
+		k.SetPlayerInfo(ctx, elem)
+	}
 	// this line is used by starport scaffolding # genesis/module/init
 	k.SetParams(ctx, genState.Params)
 }
@@ -29,6 +37,7 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		genesis.SystemInfo = systemInfo
 	}
 	genesis.StoredGameList = k.GetAllStoredGame(ctx)
+	genesis.PlayerInfoList = k.GetAllPlayerInfo(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
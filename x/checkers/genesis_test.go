@@ -25,6 +25,14 @@ func TestGenesis(t *testing.T) {
 				Index: "1",
 			},
 		},
+		PlayerInfoList: []types.PlayerInfo{
+			{
+				Index: "0",
+			},
+			{
+				Index: "1",
+			},
+		},
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
@@ -38,5 +46,26 @@ func TestGenesis(t *testing.T) {
 
 	require.Equal(t, genesisState.SystemInfo, got.SystemInfo)
 	require.ElementsMatch(t, genesisState.StoredGameList, got.StoredGameList)
+	require.ElementsMatch(t, genesisState.PlayerInfoList, got.PlayerInfoList)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
+
+func TestGenesisBoardAsFen(t *testing.T) {
+	genesisState := types.GenesisState{
+		Params: types.DefaultParams(),
+		StoredGameList: []types.StoredGame{
+			{
+				Index: "1",
+				Board: "W:W18,K31:B1,14",
+			},
+		},
+	}
+
+	k, ctx := keepertest.CheckersKeeper(t)
+	checkers.InitGenesis(ctx, *k, genesisState)
+
+	storedGame, found := k.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, "*b******|********|********|**b*****|***r****|********|********|****R***", storedGame.Board)
+	require.Equal(t, "r", storedGame.Turn)
+}
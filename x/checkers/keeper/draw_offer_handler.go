@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"github.com/bekauz/checkers/x/checkers/rules"
+	"github.com/bekauz/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// getAnswerableDrawOffer returns the game when it has a pending draw offer
+// that the creator is allowed to accept or decline, that is when the creator
+// plays the opponent of the offering color.
+func (k Keeper) getAnswerableDrawOffer(ctx sdk.Context, gameIndex string, creator string) (storedGame types.StoredGame, err error) {
+	storedGame, found := k.GetStoredGame(ctx, gameIndex)
+	if !found {
+		return storedGame, sdkerrors.Wrapf(types.ErrGameNotFound, "%s", gameIndex)
+	}
+	if storedGame.IsFinished() {
+		return storedGame, sdkerrors.Wrapf(types.ErrGameFinished, "%s", gameIndex)
+	}
+	if _, found := storedGame.GetPlayerColor(creator); !found {
+		return storedGame, sdkerrors.Wrapf(types.ErrCreatorNotPlayer, "%s", creator)
+	}
+	if !storedGame.HasDrawOffer() {
+		return storedGame, sdkerrors.Wrapf(types.ErrNoDrawOffer, "%s", gameIndex)
+	}
+	answerer, found, err := storedGame.GetPlayerAddress(rules.PieceStrings[rules.Opponents[rules.StringPieces[storedGame.DrawOffer].Player]])
+	if err != nil {
+		return storedGame, err
+	}
+	if !found || answerer.String() != creator {
+		return storedGame, sdkerrors.Wrapf(types.ErrOwnDrawOffer, "%s", creator)
+	}
+	return storedGame, nil
+}
@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bekauz/checkers/x/checkers/rules"
+	"github.com/bekauz/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ForfeitExpiredGames ends every active game whose deadline has passed. The
+// player whose turn it is loses and the winner collects the escrowed wagers,
+// unless no move was ever played, in which case the game is simply removed.
+// Games are queued in deadline order, so it stops at the first game that has
+// not expired.
+func (k Keeper) ForfeitExpiredGames(goCtx context.Context) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	systemInfo, found := k.GetSystemInfo(ctx)
+	if !found {
+		panic("SystemInfo not found")
+	}
+
+	storedGameId := systemInfo.FifoHeadIndex
+	var storedGame types.StoredGame
+	for {
+		// Finished moving along the FIFO
+		if storedGameId == types.NoFifoIndex {
+			break
+		}
+		storedGame, found = k.GetStoredGame(ctx, storedGameId)
+		if !found {
+			panic(fmt.Sprintf("Fifo head game not found %s", storedGameId))
+		}
+		deadline, err := storedGame.GetDeadlineAsTime()
+		if err != nil {
+			panic(err)
+		}
+		if !deadline.Before(ctx.BlockTime()) {
+			// All other games after are active anyway
+			break
+		}
+
+		k.RemoveFromFifo(ctx, &storedGame, &systemInfo)
+		if storedGame.MoveCount == 0 {
+			// nobody played, there is no one to reward
+			k.RemoveStoredGame(ctx, storedGameId)
+		} else {
+			storedGame.Winner = rules.PieceStrings[rules.Opponents[rules.StringPieces[storedGame.Turn].Player]]
+			k.MustPayWinnings(ctx, &storedGame)
+			k.MustRegisterPlayerForfeit(ctx, &storedGame)
+			k.SetStoredGame(ctx, storedGame)
+		}
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.GameForfeitedEventType,
+			sdk.NewAttribute(types.GameForfeitedEventGameIndex, storedGameId),
+			sdk.NewAttribute(types.GameForfeitedEventWinner, storedGame.Winner),
+			sdk.NewAttribute(types.GameForfeitedEventBoard, storedGame.Board),
+		))
+
+		// Move along FIFO
+		storedGameId = systemInfo.FifoHeadIndex
+	}
+
+	k.SetSystemInfo(ctx, systemInfo)
+}
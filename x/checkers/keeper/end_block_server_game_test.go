@@ -0,0 +1,116 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	testutil "github.com/bekauz/checkers/x/checkers/testutil"
+	"github.com/bekauz/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForfeitUnplayed(t *testing.T) {
+	_, keeper, context := setupMsgServerWithOneGameForPlayMove(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	game1, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	game1.Deadline = types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-1)))
+	keeper.SetStoredGame(ctx, game1)
+	keeper.ForfeitExpiredGames(context)
+
+	_, found = keeper.GetStoredGame(ctx, "1")
+	require.False(t, found)
+
+	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+	require.Len(t, events, 2)
+	require.EqualValues(t, sdk.StringEvent{
+		Type: "game-forfeited",
+		Attributes: []sdk.Attribute{
+			{Key: "game-index", Value: "1"},
+			{Key: "winner", Value: "*"},
+			{Key: "board", Value: "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*"},
+		},
+	}, events[0])
+}
+
+func TestForfeitPlayedOnce(t *testing.T) {
+	msgServer, keeper, context := setupMsgServerWithOneGameForPlayMove(t)
+	msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   testutil.Bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       2,
+		ToY:       3,
+	})
+	ctx := sdk.UnwrapSDKContext(context)
+	game1, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	game1.Deadline = types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-1)))
+	keeper.SetStoredGame(ctx, game1)
+	keeper.ForfeitExpiredGames(context)
+
+	game1, found = keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, "b", game1.Winner)
+	require.True(t, game1.IsFinished())
+
+	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+	require.Len(t, events, 3)
+	require.EqualValues(t, sdk.StringEvent{
+		Type: "game-forfeited",
+		Attributes: []sdk.Attribute{
+			{Key: "game-index", Value: "1"},
+			{Key: "winner", Value: "b"},
+			{Key: "board", Value: "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*"},
+		},
+	}, events[0])
+}
+
+func TestForfeitNotExpired(t *testing.T) {
+	msgServer, keeper, context := setupMsgServerWithOneGameForPlayMove(t)
+	msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   testutil.Bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       2,
+		ToY:       3,
+	})
+	ctx := sdk.UnwrapSDKContext(context)
+	keeper.ForfeitExpiredGames(context)
+
+	game1, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, "*", game1.Winner)
+}
+
+func TestForfeitStopsAtFirstActive(t *testing.T) {
+	msgServer, keeper, context := setupMsgServerWithOneGameForPlayMove(t)
+	msgServer.CreateGame(context, &types.MsgCreateGame{
+		Creator: testutil.Alice,
+		Black:   testutil.Carol,
+		Red:     testutil.Bob,
+	})
+	ctx := sdk.UnwrapSDKContext(context)
+	game1, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	game1.Deadline = types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-1)))
+	keeper.SetStoredGame(ctx, game1)
+	keeper.ForfeitExpiredGames(context)
+
+	_, found = keeper.GetStoredGame(ctx, "1")
+	require.False(t, found)
+	game2, found := keeper.GetStoredGame(ctx, "2")
+	require.True(t, found)
+	require.Equal(t, "-1", game2.BeforeIndex)
+	require.Equal(t, "-1", game2.AfterIndex)
+	systemInfo, found := keeper.GetSystemInfo(ctx)
+	require.True(t, found)
+	require.EqualValues(t, types.SystemInfo{
+		NextId:        3,
+		FifoHeadIndex: "2",
+		FifoTailIndex: "2",
+	}, systemInfo)
+}
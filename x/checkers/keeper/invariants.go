@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/bekauz/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const fifoInvariantName = "fifo-links"
+
+// RegisterInvariants registers all checkers invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, fifoInvariantName, FifoInvariant(k))
+}
+
+// FifoInvariant checks that the expiry FIFO is a well-formed doubly-linked
+// list, from head to tail, that only holds active games.
+func FifoInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		msg, broken := k.checkFifo(ctx)
+		return sdk.FormatInvariant(types.ModuleName, fifoInvariantName, msg), broken
+	}
+}
+
+func (k Keeper) checkFifo(ctx sdk.Context) (string, bool) {
+	systemInfo, found := k.GetSystemInfo(ctx)
+	if !found {
+		// genesis has not reached this module yet
+		return "SystemInfo not set, no Fifo to check\n", false
+	}
+	if (systemInfo.FifoHeadIndex == types.NoFifoIndex) != (systemInfo.FifoTailIndex == types.NoFifoIndex) {
+		return fmt.Sprintf("Fifo has head %s and tail %s\n", systemInfo.FifoHeadIndex, systemInfo.FifoTailIndex), true
+	}
+
+	visited := make(map[string]bool)
+	previousId := types.NoFifoIndex
+	for storedGameId := systemInfo.FifoHeadIndex; storedGameId != types.NoFifoIndex; {
+		if visited[storedGameId] {
+			return fmt.Sprintf("Fifo loops back to game %s\n", storedGameId), true
+		}
+		visited[storedGameId] = true
+		storedGame, found := k.GetStoredGame(ctx, storedGameId)
+		if !found {
+			return fmt.Sprintf("Fifo game %s not found\n", storedGameId), true
+		}
+		if storedGame.BeforeIndex != previousId {
+			return fmt.Sprintf("Fifo game %s points back to %s instead of %s\n", storedGameId, storedGame.BeforeIndex, previousId), true
+		}
+		if storedGame.IsFinished() {
+			return fmt.Sprintf("Fifo game %s is already finished\n", storedGameId), true
+		}
+		previousId = storedGameId
+		storedGameId = storedGame.AfterIndex
+	}
+	if previousId != systemInfo.FifoTailIndex {
+		return fmt.Sprintf("Fifo ends at %s instead of tail %s\n", previousId, systemInfo.FifoTailIndex), true
+	}
+	return fmt.Sprintf("Fifo holds %d games\n", len(visited)), false
+}
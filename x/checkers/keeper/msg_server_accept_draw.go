@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/bekauz/checkers/x/checkers/rules"
+	"github.com/bekauz/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) AcceptDraw(goCtx context.Context, msg *types.MsgAcceptDraw) (*types.MsgAcceptDrawResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	storedGame, err := k.Keeper.getAnswerableDrawOffer(ctx, msg.GameIndex, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	storedGame.DrawOffer = ""
+	storedGame.Winner = rules.PieceStrings[rules.DRAW_PLAYER]
+
+	// a finished game can no longer expire
+	systemInfo, found := k.Keeper.GetSystemInfo(ctx)
+	if !found {
+		panic("SystemInfo not found")
+	}
+	k.Keeper.RemoveFromFifo(ctx, &storedGame, &systemInfo)
+	k.Keeper.MustRefundWager(ctx, &storedGame)
+	k.Keeper.MustRegisterPlayerDraw(ctx, &storedGame)
+	k.Keeper.SetStoredGame(ctx, storedGame)
+	k.Keeper.SetSystemInfo(ctx, systemInfo)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.DrawAcceptedEventType,
+		sdk.NewAttribute(types.DrawAcceptedEventCreator, msg.Creator),
+		sdk.NewAttribute(types.DrawAcceptedEventGameIndex, msg.GameIndex),
+		sdk.NewAttribute(types.DrawAcceptedEventBoard, storedGame.Board),
+	))
+
+	return &types.MsgAcceptDrawResponse{
+		Winner: storedGame.Winner,
+	}, nil
+}
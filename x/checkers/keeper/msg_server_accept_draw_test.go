@@ -0,0 +1,121 @@
+package keeper_test
+
+import (
+	"testing"
+
+	testutil "github.com/bekauz/checkers/x/checkers/testutil"
+	"github.com/bekauz/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAcceptDrawFinishesGame(t *testing.T) {
+	msgServer, k, context := setupMsgServerWithOneGameForPlayMove(t)
+	msgServer.OfferDraw(context, &types.MsgOfferDraw{
+		Creator:   testutil.Bob,
+		GameIndex: "1",
+	})
+
+	response, err := msgServer.AcceptDraw(context, &types.MsgAcceptDraw{
+		Creator:   testutil.Carol,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, &types.MsgAcceptDrawResponse{Winner: "d"}, response)
+
+	ctx := sdk.UnwrapSDKContext(context)
+	storedGame, found := k.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.True(t, storedGame.IsFinished())
+	require.True(t, storedGame.IsDraw())
+	require.False(t, storedGame.HasDrawOffer())
+	require.Equal(t, types.NoFifoIndex, storedGame.BeforeIndex)
+	require.Equal(t, types.NoFifoIndex, storedGame.AfterIndex)
+	systemInfo, _ := k.GetSystemInfo(ctx)
+	require.Equal(t, types.NoFifoIndex, systemInfo.FifoHeadIndex)
+
+	bobInfo, found := k.GetPlayerInfo(ctx, testutil.Bob)
+	require.True(t, found)
+	require.EqualValues(t, 1, bobInfo.DrawnCount)
+	carolInfo, found := k.GetPlayerInfo(ctx, testutil.Carol)
+	require.True(t, found)
+	require.EqualValues(t, 1, carolInfo.DrawnCount)
+}
+
+func TestAcceptDrawOwnOffer(t *testing.T) {
+	msgServer, _, context := setupMsgServerWithOneGameForPlayMove(t)
+	msgServer.OfferDraw(context, &types.MsgOfferDraw{
+		Creator:   testutil.Bob,
+		GameIndex: "1",
+	})
+
+	_, err := msgServer.AcceptDraw(context, &types.MsgAcceptDraw{
+		Creator:   testutil.Bob,
+		GameIndex: "1",
+	})
+	require.ErrorIs(t, err, types.ErrOwnDrawOffer)
+}
+
+func TestAcceptDrawWithoutOffer(t *testing.T) {
+	msgServer, _, context := setupMsgServerWithOneGameForPlayMove(t)
+
+	_, err := msgServer.AcceptDraw(context, &types.MsgAcceptDraw{
+		Creator:   testutil.Carol,
+		GameIndex: "1",
+	})
+	require.ErrorIs(t, err, types.ErrNoDrawOffer)
+}
+
+func TestAcceptDrawNotPlayer(t *testing.T) {
+	msgServer, _, context := setupMsgServerWithOneGameForPlayMove(t)
+	msgServer.OfferDraw(context, &types.MsgOfferDraw{
+		Creator:   testutil.Bob,
+		GameIndex: "1",
+	})
+
+	_, err := msgServer.AcceptDraw(context, &types.MsgAcceptDraw{
+		Creator:   testutil.Alice,
+		GameIndex: "1",
+	})
+	require.ErrorIs(t, err, types.ErrCreatorNotPlayer)
+}
+
+func TestAcceptDrawRefundsWagers(t *testing.T) {
+	msgServer, _, context, bank := setupMsgServerWithOneWagerGame(t)
+	playFirstTwoMoves(t, msgServer, context)
+	msgServer.OfferDraw(context, &types.MsgOfferDraw{
+		Creator:   testutil.Bob,
+		GameIndex: "1",
+	})
+
+	_, err := msgServer.AcceptDraw(context, &types.MsgAcceptDraw{
+		Creator:   testutil.Carol,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+	require.True(t, bank.ModuleBalance(types.ModuleName).IsZero())
+	require.Equal(t, "100stake", bank.Balances[testutil.Bob].String())
+	require.Equal(t, "100stake", bank.Balances[testutil.Carol].String())
+}
+
+func TestAcceptDrawLocksGame(t *testing.T) {
+	msgServer, _, context := setupMsgServerWithOneGameForPlayMove(t)
+	msgServer.OfferDraw(context, &types.MsgOfferDraw{
+		Creator:   testutil.Carol,
+		GameIndex: "1",
+	})
+	msgServer.AcceptDraw(context, &types.MsgAcceptDraw{
+		Creator:   testutil.Bob,
+		GameIndex: "1",
+	})
+
+	_, err := msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   testutil.Bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       2,
+		ToY:       3,
+	})
+	require.ErrorIs(t, err, types.ErrGameFinished)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/bekauz/checkers/x/checkers/rules"
 	"github.com/bekauz/checkers/x/checkers/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 func (k msgServer) CreateGame(goCtx context.Context, msg *types.MsgCreateGame) (*types.MsgCreateGameResponse, error) {
@@ -20,13 +21,39 @@ func (k msgServer) CreateGame(goCtx context.Context, msg *types.MsgCreateGame) (
 	newIndex := strconv.FormatUint(systemInfo.NextId, 10)
 
 	// create a new game and the object to store
-	newGame := rules.New()
+	ruleSet, found := rules.GetRuleSet(msg.Variant)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidVariant, "%s", msg.Variant)
+	}
+	newGame := rules.NewGame(ruleSet)
+	startPosition := ""
+	if msg.Board != "" {
+		customGame, err := rules.ParsePosition(ruleSet, msg.Board)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidStartPosition, "%s", err)
+		}
+		// a game has to be playable from its start
+		if customGame.Winner() != rules.NO_PLAYER {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidStartPosition, "game already over: %s", msg.Board)
+		}
+		newGame = customGame
+		startPosition = newGame.Fen()
+	}
 	storedGame := types.StoredGame{
-		Index: newIndex,
-		Board: newGame.String(),
-		Turn:  rules.PieceStrings[newGame.Turn],
-		Black: msg.Black,
-		Red:   msg.Red,
+		Index:         newIndex,
+		Board:         newGame.String(),
+		Turn:          rules.PieceStrings[newGame.Turn],
+		Black:         msg.Black,
+		Red:           msg.Red,
+		Winner:        rules.PieceStrings[rules.NO_PLAYER],
+		Deadline:      types.FormatDeadline(types.GetNextDeadline(ctx, k.Keeper.MaxTurnDuration(ctx))),
+		MoveCount:     0,
+		BeforeIndex:   types.NoFifoIndex,
+		AfterIndex:    types.NoFifoIndex,
+		Wager:         msg.Wager,
+		Denom:         msg.Denom,
+		Variant:       ruleSet.Name,
+		StartPosition: startPosition,
 	}
 
 	// check if the game is valid
@@ -35,7 +62,8 @@ func (k msgServer) CreateGame(goCtx context.Context, msg *types.MsgCreateGame) (
 		return nil, err
 	}
 
-	// store the game
+	// queue the game for expiry and store it
+	k.Keeper.SendToFifoTail(ctx, &storedGame, &systemInfo)
 	k.Keeper.SetStoredGame(ctx, storedGame)
 
 	// increment the id of the following game and store it
@@ -49,6 +77,9 @@ func (k msgServer) CreateGame(goCtx context.Context, msg *types.MsgCreateGame) (
 			sdk.NewAttribute(types.GameCreatedEventGameIndex, newIndex),
 			sdk.NewAttribute(types.GameCreatedEventBlack, msg.Black),
 			sdk.NewAttribute(types.GameCreatedEventRed, msg.Red),
+			sdk.NewAttribute(types.GameCreatedEventWager, strconv.FormatUint(msg.Wager, 10)),
+			sdk.NewAttribute(types.GameCreatedEventDenom, msg.Denom),
+			sdk.NewAttribute(types.GameCreatedEventVariant, ruleSet.Name),
 		),
 	)
 
@@ -52,17 +52,25 @@ func TestCreateGameHasSaved(t *testing.T) {
 	systemInfo, found := keeper.GetSystemInfo(sdk.UnwrapSDKContext(context))
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId: 2,
+		NextId:        2,
+		FifoHeadIndex: "1",
+		FifoTailIndex: "1",
 	}, systemInfo)
 
 	game, found := keeper.GetStoredGame(sdk.UnwrapSDKContext(context), "1")
 	require.True(t, found)
 	require.EqualValues(t, types.StoredGame{
-		Index: "1",
-		Board: "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
-		Turn:  "b",
-		Black: testutil.Bob,
-		Red:   testutil.Carol,
+		Index:       "1",
+		Board:       "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
+		Turn:        "b",
+		Black:       testutil.Bob,
+		Red:         testutil.Carol,
+		Winner:      "*",
+		Deadline:    "0001-01-02 00:00:00 +0000 UTC",
+		MoveCount:   0,
+		BeforeIndex: "-1",
+		AfterIndex:  "-1",
+		Variant:     "english",
 	}, game)
 }
 
@@ -89,37 +97,57 @@ func TestCreateMultipleGames(t *testing.T) {
 	systemInfo, found := keeper.GetSystemInfo(sdk.UnwrapSDKContext(context))
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId: 4,
+		NextId:        4,
+		FifoHeadIndex: "1",
+		FifoTailIndex: "3",
 	}, systemInfo)
 
 	game1, found := keeper.GetStoredGame(sdk.UnwrapSDKContext(context), "1")
 	require.True(t, found)
 	require.EqualValues(t, types.StoredGame{
-		Index: "1",
-		Board: "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
-		Turn:  "b",
-		Black: testutil.Bob,
-		Red:   testutil.Carol,
+		Index:       "1",
+		Board:       "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
+		Turn:        "b",
+		Black:       testutil.Bob,
+		Red:         testutil.Carol,
+		Winner:      "*",
+		Deadline:    "0001-01-02 00:00:00 +0000 UTC",
+		MoveCount:   0,
+		BeforeIndex: "-1",
+		AfterIndex:  "2",
+		Variant:     "english",
 	}, game1)
 
 	game2, found := keeper.GetStoredGame(sdk.UnwrapSDKContext(context), "2")
 	require.True(t, found)
 	require.EqualValues(t, types.StoredGame{
-		Index: "2",
-		Board: "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
-		Turn:  "b",
-		Black: testutil.Carol,
-		Red:   testutil.Bob,
+		Index:       "2",
+		Board:       "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
+		Turn:        "b",
+		Black:       testutil.Carol,
+		Red:         testutil.Bob,
+		Winner:      "*",
+		Deadline:    "0001-01-02 00:00:00 +0000 UTC",
+		MoveCount:   0,
+		BeforeIndex: "1",
+		AfterIndex:  "3",
+		Variant:     "english",
 	}, game2)
 
 	game3, found := keeper.GetStoredGame(sdk.UnwrapSDKContext(context), "3")
 	require.True(t, found)
 	require.EqualValues(t, types.StoredGame{
-		Index: "3",
-		Board: "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
-		Turn:  "b",
-		Black: testutil.Alice,
-		Red:   testutil.Carol,
+		Index:       "3",
+		Board:       "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
+		Turn:        "b",
+		Black:       testutil.Alice,
+		Red:         testutil.Carol,
+		Winner:      "*",
+		Deadline:    "0001-01-02 00:00:00 +0000 UTC",
+		MoveCount:   0,
+		BeforeIndex: "2",
+		AfterIndex:  "-1",
+		Variant:     "english",
 	}, game3)
 }
 
@@ -143,6 +171,119 @@ func TestCreate1GameEmitted(t *testing.T) {
 			{Key: "game-index", Value: "1"},
 			{Key: "black", Value: testutil.Bob},
 			{Key: "red", Value: testutil.Carol},
+			{Key: "wager", Value: "0"},
+			{Key: "denom", Value: ""},
+			{Key: "variant", Value: "english"},
 		},
 	}, event)
 }
+
+func TestCreateInternationalGame(t *testing.T) {
+	msgServer, keeper, context := setupMsgServerCreateGame(t)
+	_, err := msgServer.CreateGame(context, &types.MsgCreateGame{
+		Creator: testutil.Alice,
+		Black:   testutil.Bob,
+		Red:     testutil.Carol,
+		Variant: "international",
+	})
+	require.Nil(t, err)
+
+	game, found := keeper.GetStoredGame(sdk.UnwrapSDKContext(context), "1")
+	require.True(t, found)
+	require.Equal(t, "international", game.Variant)
+	require.Equal(t, "*b*b*b*b*b|b*b*b*b*b*|*b*b*b*b*b|b*b*b*b*b*|**********|**********|*r*r*r*r*r|r*r*r*r*r*|*r*r*r*r*r|r*r*r*r*r*", game.Board)
+
+	_, err = msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   testutil.Bob,
+		GameIndex: "1",
+		FromX:     0,
+		FromY:     3,
+		ToX:       1,
+		ToY:       4,
+	})
+	require.Nil(t, err)
+}
+
+func TestCreateTurkishGame(t *testing.T) {
+	msgServer, keeper, context := setupMsgServerCreateGame(t)
+	_, err := msgServer.CreateGame(context, &types.MsgCreateGame{
+		Creator: testutil.Alice,
+		Black:   testutil.Bob,
+		Red:     testutil.Carol,
+		Variant: "turkish",
+	})
+	require.Nil(t, err)
+
+	game, found := keeper.GetStoredGame(sdk.UnwrapSDKContext(context), "1")
+	require.True(t, found)
+	require.Equal(t, "turkish", game.Variant)
+	require.Equal(t, "********|bbbbbbbb|bbbbbbbb|********|********|rrrrrrrr|rrrrrrrr|********", game.Board)
+
+	_, err = msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   testutil.Bob,
+		GameIndex: "1",
+		FromX:     3,
+		FromY:     2,
+		ToX:       3,
+		ToY:       3,
+	})
+	require.Nil(t, err)
+	game, _ = keeper.GetStoredGame(sdk.UnwrapSDKContext(context), "1")
+	require.Equal(t, "********|bbbbbbbb|bbb*bbbb|***b****|********|rrrrrrrr|rrrrrrrr|********", game.Board)
+}
+
+func TestCreateGameFromFen(t *testing.T) {
+	msgServer, keeper, context := setupMsgServerCreateGame(t)
+	_, err := msgServer.CreateGame(context, &types.MsgCreateGame{
+		Creator: testutil.Alice,
+		Black:   testutil.Bob,
+		Red:     testutil.Carol,
+		Board:   "W:W18,K31:B1,14",
+	})
+	require.Nil(t, err)
+
+	game, found := keeper.GetStoredGame(sdk.UnwrapSDKContext(context), "1")
+	require.True(t, found)
+	require.Equal(t, "*b******|********|********|**b*****|***r****|********|********|****R***", game.Board)
+	require.Equal(t, "r", game.Turn)
+	require.Equal(t, "W:W18,K31:B1,14", game.StartPosition)
+
+	_, err = msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   testutil.Carol,
+		GameIndex: "1",
+		FromX:     3,
+		FromY:     4,
+		ToX:       1,
+		ToY:       2,
+	})
+	require.Nil(t, err)
+	game, _ = keeper.GetStoredGame(sdk.UnwrapSDKContext(context), "1")
+	pdn, err := game.ExportPdn()
+	require.Nil(t, err)
+	require.Equal(t, "W:W18,K31:B1,14", pdn.Tag("FEN"))
+	replayed, err := pdn.Replay()
+	require.Nil(t, err)
+	require.Equal(t, game.Board, replayed.String())
+}
+
+func TestCreateGameFromFinishedPosition(t *testing.T) {
+	msgServer, _, context := setupMsgServerCreateGame(t)
+	_, err := msgServer.CreateGame(context, &types.MsgCreateGame{
+		Creator: testutil.Alice,
+		Black:   testutil.Bob,
+		Red:     testutil.Carol,
+		Board:   "W:W:B1",
+	})
+	require.ErrorIs(t, err, types.ErrInvalidStartPosition)
+}
+
+func TestCreateGameUnknownVariant(t *testing.T) {
+	msgServer, _, context := setupMsgServerCreateGame(t)
+	_, err := msgServer.CreateGame(context, &types.MsgCreateGame{
+		Creator: testutil.Alice,
+		Black:   testutil.Bob,
+		Red:     testutil.Carol,
+		Variant: "chess",
+	})
+	require.ErrorIs(t, err, types.ErrInvalidVariant)
+}
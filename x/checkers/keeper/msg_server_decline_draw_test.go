@@ -0,0 +1,65 @@
+package keeper_test
+
+import (
+	"testing"
+
+	testutil "github.com/bekauz/checkers/x/checkers/testutil"
+	"github.com/bekauz/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeclineDrawClearsOffer(t *testing.T) {
+	msgServer, k, context := setupMsgServerWithOneGameForPlayMove(t)
+	msgServer.OfferDraw(context, &types.MsgOfferDraw{
+		Creator:   testutil.Bob,
+		GameIndex: "1",
+	})
+
+	response, err := msgServer.DeclineDraw(context, &types.MsgDeclineDraw{
+		Creator:   testutil.Carol,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, &types.MsgDeclineDrawResponse{}, response)
+	storedGame, _ := k.GetStoredGame(sdk.UnwrapSDKContext(context), "1")
+	require.False(t, storedGame.HasDrawOffer())
+	require.False(t, storedGame.IsFinished())
+}
+
+func TestDeclineDrawOwnOffer(t *testing.T) {
+	msgServer, _, context := setupMsgServerWithOneGameForPlayMove(t)
+	msgServer.OfferDraw(context, &types.MsgOfferDraw{
+		Creator:   testutil.Carol,
+		GameIndex: "1",
+	})
+
+	_, err := msgServer.DeclineDraw(context, &types.MsgDeclineDraw{
+		Creator:   testutil.Carol,
+		GameIndex: "1",
+	})
+	require.ErrorIs(t, err, types.ErrOwnDrawOffer)
+}
+
+func TestDeclineDrawEventEmitted(t *testing.T) {
+	msgServer, _, context := setupMsgServerWithOneGameForPlayMove(t)
+	msgServer.OfferDraw(context, &types.MsgOfferDraw{
+		Creator:   testutil.Bob,
+		GameIndex: "1",
+	})
+	msgServer.DeclineDraw(context, &types.MsgDeclineDraw{
+		Creator:   testutil.Carol,
+		GameIndex: "1",
+	})
+
+	ctx := sdk.UnwrapSDKContext(context)
+	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+	require.Len(t, events, 3) // created, offered and declined
+	require.EqualValues(t, sdk.StringEvent{
+		Type: "draw-declined",
+		Attributes: []sdk.Attribute{
+			{Key: "creator", Value: testutil.Carol},
+			{Key: "game-index", Value: "1"},
+		},
+	}, events[0])
+}
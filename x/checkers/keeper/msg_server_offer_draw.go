@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/bekauz/checkers/x/checkers/rules"
+	"github.com/bekauz/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) OfferDraw(goCtx context.Context, msg *types.MsgOfferDraw) (*types.MsgOfferDrawResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// get the stored game
+	storedGame, found := k.Keeper.GetStoredGame(ctx, msg.GameIndex)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrGameNotFound, "%s", msg.GameIndex)
+	}
+
+	// a game with a winner is locked
+	if storedGame.IsFinished() {
+		return nil, sdkerrors.Wrapf(types.ErrGameFinished, "%s", msg.GameIndex)
+	}
+
+	player, found := storedGame.GetPlayerColor(msg.Creator)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrCreatorNotPlayer, "%s", msg.Creator)
+	}
+
+	// only one offer can be pending at a time
+	if storedGame.HasDrawOffer() {
+		return nil, sdkerrors.Wrapf(types.ErrDrawAlreadyOffered, "%s", storedGame.DrawOffer)
+	}
+
+	storedGame.DrawOffer = rules.PieceStrings[player]
+	k.Keeper.SetStoredGame(ctx, storedGame)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.DrawOfferedEventType,
+		sdk.NewAttribute(types.DrawOfferedEventCreator, msg.Creator),
+		sdk.NewAttribute(types.DrawOfferedEventGameIndex, msg.GameIndex),
+	))
+
+	return &types.MsgOfferDrawResponse{}, nil
+}
@@ -0,0 +1,110 @@
+package keeper_test
+
+import (
+	"testing"
+
+	testutil "github.com/bekauz/checkers/x/checkers/testutil"
+	"github.com/bekauz/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOfferDrawSaved(t *testing.T) {
+	msgServer, k, context := setupMsgServerWithOneGameForPlayMove(t)
+
+	response, err := msgServer.OfferDraw(context, &types.MsgOfferDraw{
+		Creator:   testutil.Carol,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, &types.MsgOfferDrawResponse{}, response)
+	storedGame, found := k.GetStoredGame(sdk.UnwrapSDKContext(context), "1")
+	require.True(t, found)
+	require.Equal(t, "r", storedGame.DrawOffer)
+	require.False(t, storedGame.IsFinished())
+}
+
+func TestOfferDrawNotPlayer(t *testing.T) {
+	msgServer, _, context := setupMsgServerWithOneGameForPlayMove(t)
+
+	_, err := msgServer.OfferDraw(context, &types.MsgOfferDraw{
+		Creator:   testutil.Alice,
+		GameIndex: "1",
+	})
+	require.ErrorIs(t, err, types.ErrCreatorNotPlayer)
+}
+
+func TestOfferDrawTwice(t *testing.T) {
+	msgServer, _, context := setupMsgServerWithOneGameForPlayMove(t)
+	_, err := msgServer.OfferDraw(context, &types.MsgOfferDraw{
+		Creator:   testutil.Bob,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+
+	_, err = msgServer.OfferDraw(context, &types.MsgOfferDraw{
+		Creator:   testutil.Carol,
+		GameIndex: "1",
+	})
+	require.ErrorIs(t, err, types.ErrDrawAlreadyOffered)
+}
+
+func TestOfferDrawKeptWhenOffererMoves(t *testing.T) {
+	msgServer, k, context := setupMsgServerWithOneGameForPlayMove(t)
+	msgServer.OfferDraw(context, &types.MsgOfferDraw{
+		Creator:   testutil.Bob,
+		GameIndex: "1",
+	})
+
+	_, err := msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   testutil.Bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       2,
+		ToY:       3,
+	})
+	require.Nil(t, err)
+	storedGame, _ := k.GetStoredGame(sdk.UnwrapSDKContext(context), "1")
+	require.Equal(t, "b", storedGame.DrawOffer)
+}
+
+func TestOfferDrawClearedWhenOpponentMoves(t *testing.T) {
+	msgServer, k, context := setupMsgServerWithOneGameForPlayMove(t)
+	msgServer.OfferDraw(context, &types.MsgOfferDraw{
+		Creator:   testutil.Carol,
+		GameIndex: "1",
+	})
+
+	_, err := msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   testutil.Bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       2,
+		ToY:       3,
+	})
+	require.Nil(t, err)
+	storedGame, _ := k.GetStoredGame(sdk.UnwrapSDKContext(context), "1")
+	require.False(t, storedGame.HasDrawOffer())
+}
+
+func TestOfferDrawEventEmitted(t *testing.T) {
+	msgServer, _, context := setupMsgServerWithOneGameForPlayMove(t)
+
+	msgServer.OfferDraw(context, &types.MsgOfferDraw{
+		Creator:   testutil.Bob,
+		GameIndex: "1",
+	})
+
+	ctx := sdk.UnwrapSDKContext(context)
+	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+	require.Len(t, events, 2) // created and offered
+	require.EqualValues(t, sdk.StringEvent{
+		Type: "draw-offered",
+		Attributes: []sdk.Attribute{
+			{Key: "creator", Value: testutil.Bob},
+			{Key: "game-index", Value: "1"},
+		},
+	}, events[0])
+}
@@ -13,51 +13,31 @@ import (
 func (k msgServer) PlayMove(goCtx context.Context, msg *types.MsgPlayMove) (*types.MsgPlayMoveResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	// get the stored game
-	storedGame, found := k.Keeper.GetStoredGame(ctx, msg.GameIndex)
-	if !found {
-		return nil, sdkerrors.Wrapf(types.ErrGameNotFound, "%s", msg.GameIndex)
+	storedGame, game, player, err := k.Keeper.getGameToPlay(ctx, msg.GameIndex, msg.Creator)
+	if err != nil {
+		return nil, err
 	}
 
-	// determine player color
-	isBlack := storedGame.Black == msg.Creator
-	isRed := storedGame.Red == msg.Creator
-	var player rules.Player
-	if !isBlack && isRed {
-		player = rules.RED_PLAYER
-	} else if isBlack && !isRed {
-		player = rules.BLACK_PLAYER
-	} else {
-		player = rules.StringPieces[storedGame.Turn].Player
-	}
-	// parse the game
-	game, err := storedGame.ParseGame()
+	// escrow the wager on the player's first move
+	err = k.Keeper.CollectWager(ctx, &storedGame, player)
 	if err != nil {
-		panic(err.Error())
+		return nil, err
 	}
 
-	// validate the player turn
-	if !game.TurnIs(player) {
-		return nil, sdkerrors.Wrapf(types.ErrNotPlayerTurn, "%s", player)
+	src := rules.Pos{
+		X: int(msg.FromX),
+		Y: int(msg.FromY),
 	}
-
-	captured, moveErr := game.Move(
-		rules.Pos{
-			X: int(msg.FromX),
-			Y: int(msg.FromY),
-		},
-		rules.Pos{
-			X: int(msg.ToX),
-			Y: int(msg.ToY),
-		},
-	)
+	dst := rules.Pos{
+		X: int(msg.ToX),
+		Y: int(msg.ToY),
+	}
+	captured, moveErr := game.Move(src, dst)
 	if moveErr != nil {
 		return nil, sdkerrors.Wrapf(types.ErrWrongMove, moveErr.Error())
 	}
 
-	storedGame.Board = game.String()
-	storedGame.Turn = rules.PieceStrings[game.Turn]
-	k.Keeper.SetStoredGame(ctx, storedGame)
+	k.Keeper.mustSavePlayedMove(ctx, &storedGame, game, player, []rules.Pos{src, dst}, captured != rules.NO_POS)
 
 	// emit the move event
 	ctx.EventManager().EmitEvent(sdk.NewEvent(
@@ -66,12 +46,17 @@ func (k msgServer) PlayMove(goCtx context.Context, msg *types.MsgPlayMove) (*typ
 		sdk.NewAttribute(types.MovePlayedEventCapturedY, strconv.FormatInt(int64(captured.Y), 10)),
 		sdk.NewAttribute(types.MovePlayedEventCreator, msg.Creator),
 		sdk.NewAttribute(types.MovePlayedEventGameIndex, msg.GameIndex),
-		sdk.NewAttribute(types.MovePlayedEventWinner, rules.PieceStrings[game.Winner()]),
+		sdk.NewAttribute(types.MovePlayedEventWinner, storedGame.Winner),
 	))
 
+	// emit the game over event
+	if storedGame.IsFinished() {
+		emitGameOverEvent(ctx, storedGame)
+	}
+
 	return &types.MsgPlayMoveResponse{
 		CapturedX: int32(captured.X),
 		CapturedY: int32(captured.Y),
-		Winner:    rules.PieceStrings[game.Winner()],
+		Winner:    storedGame.Winner,
 	}, nil
 }
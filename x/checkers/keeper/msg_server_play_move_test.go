@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	keepertest "github.com/bekauz/checkers/testutil/keeper"
 	"github.com/bekauz/checkers/x/checkers"
@@ -105,6 +106,21 @@ func TestPlayMoveNotPlayerTurn(t *testing.T) {
 	require.Equal(t, "{red}: player tried to play out of turn", err.Error())
 }
 
+func TestPlayMoveNotPlayer(t *testing.T) {
+	msgServer, _, context := setupMsgServerWithOneGameForPlayMove(t)
+
+	_, err := msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   testutil.Alice,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       2,
+		ToY:       3,
+	})
+
+	require.ErrorIs(t, err, types.ErrCreatorNotPlayer)
+}
+
 func TestPlayMoveWrongMove(t *testing.T) {
 	msgServer, _, context := setupMsgServerWithOneGameForPlayMove(t)
 
@@ -224,3 +240,249 @@ func TestPlayMoveMultipleEmittedEvents(t *testing.T) {
 		{Key: "winner", Value: "*"},
 	}, event.Attributes[5:])
 }
+
+// leaves a single red piece that black can capture with its next move
+func setupMsgServerWithOneGameCloseToWin(t testing.TB) (types.MsgServer, keeper.Keeper, context.Context) {
+	msgServer, k, context := setupMsgServerWithOneGameForPlayMove(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	storedGame, _ := k.GetStoredGame(ctx, "1")
+	storedGame.Board = "********|********|*b******|**r*****|********|********|********|********"
+	k.SetStoredGame(ctx, storedGame)
+	return msgServer, k, context
+}
+
+func TestPlayMoveWinnerSaved(t *testing.T) {
+	msgServer, k, context := setupMsgServerWithOneGameCloseToWin(t)
+
+	playMoveResponse, err := msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   testutil.Bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       3,
+		ToY:       4,
+	})
+
+	require.Nil(t, err)
+	require.EqualValues(t, &types.MsgPlayMoveResponse{
+		CapturedX: 2,
+		CapturedY: 3,
+		Winner:    "b",
+	}, playMoveResponse)
+	storedGame, found := k.GetStoredGame(sdk.UnwrapSDKContext(context), "1")
+	require.True(t, found)
+	require.Equal(t, "b", storedGame.Winner)
+	require.True(t, storedGame.IsFinished())
+	require.Equal(t, types.NoFifoIndex, storedGame.BeforeIndex)
+	require.Equal(t, types.NoFifoIndex, storedGame.AfterIndex)
+	systemInfo, found := k.GetSystemInfo(sdk.UnwrapSDKContext(context))
+	require.True(t, found)
+	require.Equal(t, types.NoFifoIndex, systemInfo.FifoHeadIndex)
+	require.Equal(t, types.NoFifoIndex, systemInfo.FifoTailIndex)
+}
+
+func TestPlayMoveGiveawayLosingAllPiecesWins(t *testing.T) {
+	msgServer, k, context := setupMsgServerWithOneGameCloseToWin(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	storedGame, _ := k.GetStoredGame(ctx, "1")
+	storedGame.Variant = "giveaway"
+	k.SetStoredGame(ctx, storedGame)
+
+	playMoveResponse, err := msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   testutil.Bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       3,
+		ToY:       4,
+	})
+
+	require.Nil(t, err)
+	require.Equal(t, "r", playMoveResponse.Winner)
+	storedGame, _ = k.GetStoredGame(ctx, "1")
+	require.Equal(t, "r", storedGame.Winner)
+	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+	require.Equal(t, "game-over", events[0].Type)
+	require.Contains(t, events[0].Attributes, sdk.Attribute{Key: "winner", Value: "r"})
+	carolInfo, found := k.GetPlayerInfo(ctx, testutil.Carol)
+	require.True(t, found)
+	require.EqualValues(t, 1, carolInfo.WonCount)
+	bobInfo, found := k.GetPlayerInfo(ctx, testutil.Bob)
+	require.True(t, found)
+	require.EqualValues(t, 1, bobInfo.LostCount)
+}
+
+func TestPlayMoveGameOverEmitted(t *testing.T) {
+	msgServer, _, context := setupMsgServerWithOneGameCloseToWin(t)
+
+	msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   testutil.Bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       3,
+		ToY:       4,
+	})
+
+	ctx := sdk.UnwrapSDKContext(context)
+	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+	require.Len(t, events, 3) // created, playMove and gameOver
+	require.EqualValues(t, sdk.StringEvent{
+		Type: "game-over",
+		Attributes: []sdk.Attribute{
+			{Key: "game-index", Value: "1"},
+			{Key: "winner", Value: "b"},
+			{Key: "board", Value: "********|********|********|********|***b****|********|********|********"},
+		},
+	}, events[0])
+}
+
+func TestPlayMoveAfterWinnerRejected(t *testing.T) {
+	msgServer, _, context := setupMsgServerWithOneGameCloseToWin(t)
+
+	msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   testutil.Bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       3,
+		ToY:       4,
+	})
+	_, err := msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   testutil.Bob,
+		GameIndex: "1",
+		FromX:     3,
+		FromY:     4,
+		ToX:       4,
+		ToY:       5,
+	})
+
+	require.NotNil(t, err)
+	require.Equal(t, "1: game is already finished", err.Error())
+}
+
+func TestPlayMoveSavesDeadlineAndCount(t *testing.T) {
+	msgServer, k, context := setupMsgServerWithOneGameForPlayMove(t)
+	ctx := sdk.UnwrapSDKContext(context).WithBlockTime(time.Unix(1_000, 0))
+	context = sdk.WrapSDKContext(ctx)
+
+	msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   testutil.Bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       2,
+		ToY:       3,
+	})
+
+	storedGame, found := k.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.EqualValues(t, 1, storedGame.MoveCount)
+	require.Equal(t, "1970-01-02 00:16:40 +0000 UTC", storedGame.Deadline)
+}
+
+func TestPlayMoveBlockingOpponentWins(t *testing.T) {
+	msgServer, k, context := setupMsgServerWithOneGameForPlayMove(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	storedGame, _ := k.GetStoredGame(ctx, "1")
+	storedGame.Board = "********|********|********|********|***b****|********|*b******|r*******"
+	k.SetStoredGame(ctx, storedGame)
+
+	playMoveResponse, err := msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   testutil.Bob,
+		GameIndex: "1",
+		FromX:     3,
+		FromY:     4,
+		ToX:       2,
+		ToY:       5,
+	})
+
+	require.Nil(t, err)
+	require.EqualValues(t, &types.MsgPlayMoveResponse{
+		CapturedX: -1,
+		CapturedY: -1,
+		Winner:    "b",
+	}, playMoveResponse)
+	storedGame, _ = k.GetStoredGame(ctx, "1")
+	require.True(t, storedGame.IsFinished())
+	require.Equal(t, "r", storedGame.Turn)
+	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+	require.Len(t, events, 3) // created, playMove and gameOver
+	require.Equal(t, "game-over", events[0].Type)
+	bobInfo, found := k.GetPlayerInfo(ctx, testutil.Bob)
+	require.True(t, found)
+	require.EqualValues(t, 1, bobInfo.WonCount)
+}
+
+func TestPlayMoveDrawByRepetition(t *testing.T) {
+	msgServer, k, context := setupMsgServerWithOneGameForPlayMove(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	storedGame, _ := k.GetStoredGame(ctx, "1")
+	storedGame.Board = "********|B*******|********|********|********|********|*******R|********"
+	k.SetStoredGame(ctx, storedGame)
+
+	shuffle := []*types.MsgPlayMove{
+		{Creator: testutil.Bob, GameIndex: "1", FromX: 0, FromY: 1, ToX: 1, ToY: 0},
+		{Creator: testutil.Carol, GameIndex: "1", FromX: 7, FromY: 6, ToX: 6, ToY: 7},
+		{Creator: testutil.Bob, GameIndex: "1", FromX: 1, FromY: 0, ToX: 0, ToY: 1},
+		{Creator: testutil.Carol, GameIndex: "1", FromX: 6, FromY: 7, ToX: 7, ToY: 6},
+	}
+	var response *types.MsgPlayMoveResponse
+	var err error
+	for round := 0; round < 2; round++ {
+		for _, msg := range shuffle {
+			response, err = msgServer.PlayMove(context, msg)
+			require.Nil(t, err)
+		}
+	}
+
+	require.Equal(t, "d", response.Winner)
+	storedGame, _ = k.GetStoredGame(ctx, "1")
+	require.True(t, storedGame.IsDraw())
+	require.Len(t, storedGame.History, 9)
+	require.Equal(t, types.NoFifoIndex, storedGame.BeforeIndex)
+	bobInfo, found := k.GetPlayerInfo(ctx, testutil.Bob)
+	require.True(t, found)
+	require.EqualValues(t, 1, bobInfo.DrawnCount)
+
+	_, err = msgServer.PlayMove(context, shuffle[0])
+	require.ErrorIs(t, err, types.ErrGameFinished)
+}
+
+func TestPlayMoveKeepsNoHistoryWithoutDrawLimits(t *testing.T) {
+	msgServer, k, context := setupMsgServerWithOneGameForPlayMove(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	params := types.DefaultParams()
+	params.DrawRepetitionLimit = 0
+	params.DrawNoProgressLimit = 0
+	k.SetParams(ctx, params)
+	storedGame, _ := k.GetStoredGame(ctx, "1")
+	storedGame.Board = "********|B*******|********|********|********|********|*******R|********"
+	k.SetStoredGame(ctx, storedGame)
+
+	_, err := msgServer.PlayMove(context, &types.MsgPlayMove{Creator: testutil.Bob, GameIndex: "1", FromX: 0, FromY: 1, ToX: 1, ToY: 0})
+	require.Nil(t, err)
+	_, err = msgServer.PlayMove(context, &types.MsgPlayMove{Creator: testutil.Carol, GameIndex: "1", FromX: 7, FromY: 6, ToX: 6, ToY: 7})
+	require.Nil(t, err)
+	storedGame, _ = k.GetStoredGame(ctx, "1")
+	require.Empty(t, storedGame.History)
+}
+
+func TestPlayMoveDrawByNoProgressParam(t *testing.T) {
+	msgServer, k, context := setupMsgServerWithOneGameForPlayMove(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	params := types.DefaultParams()
+	params.DrawRepetitionLimit = 0
+	params.DrawNoProgressLimit = 2
+	k.SetParams(ctx, params)
+	storedGame, _ := k.GetStoredGame(ctx, "1")
+	storedGame.Board = "********|B*******|********|********|********|********|*******R|********"
+	k.SetStoredGame(ctx, storedGame)
+
+	response, err := msgServer.PlayMove(context, &types.MsgPlayMove{Creator: testutil.Bob, GameIndex: "1", FromX: 0, FromY: 1, ToX: 1, ToY: 0})
+	require.Nil(t, err)
+	require.Equal(t, "*", response.Winner)
+	response, err = msgServer.PlayMove(context, &types.MsgPlayMove{Creator: testutil.Carol, GameIndex: "1", FromX: 7, FromY: 6, ToX: 6, ToY: 7})
+	require.Nil(t, err)
+	require.Equal(t, "d", response.Winner)
+}
@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/bekauz/checkers/x/checkers/rules"
+	"github.com/bekauz/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) PlayMoves(goCtx context.Context, msg *types.MsgPlayMoves) (*types.MsgPlayMovesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	storedGame, game, player, err := k.Keeper.getGameToPlay(ctx, msg.GameIndex, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	// escrow the wager on the player's first move
+	err = k.Keeper.CollectWager(ctx, &storedGame, player)
+	if err != nil {
+		return nil, err
+	}
+
+	path := make([]rules.Pos, 0, len(msg.Path))
+	for _, position := range msg.Path {
+		path = append(path, rules.Pos{
+			X: int(position.X),
+			Y: int(position.Y),
+		})
+	}
+	captured, moveErr := game.MoveSequence(path)
+	if moveErr != nil {
+		return nil, sdkerrors.Wrapf(types.ErrWrongMove, moveErr.Error())
+	}
+
+	k.Keeper.mustSavePlayedMove(ctx, &storedGame, game, player, path, len(captured) > 0)
+
+	// emit the moves event, with one pair of coordinates per capture
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(types.MovesPlayedEventCreator, msg.Creator),
+		sdk.NewAttribute(types.MovesPlayedEventGameIndex, msg.GameIndex),
+	}
+	capturedPositions := make([]types.Position, 0, len(captured))
+	for _, pos := range captured {
+		attributes = append(attributes,
+			sdk.NewAttribute(types.MovesPlayedEventCapturedX, strconv.FormatInt(int64(pos.X), 10)),
+			sdk.NewAttribute(types.MovesPlayedEventCapturedY, strconv.FormatInt(int64(pos.Y), 10)),
+		)
+		capturedPositions = append(capturedPositions, types.Position{
+			X: uint64(pos.X),
+			Y: uint64(pos.Y),
+		})
+	}
+	attributes = append(attributes, sdk.NewAttribute(types.MovesPlayedEventWinner, storedGame.Winner))
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.MovesPlayedEventType, attributes...))
+
+	// emit the game over event
+	if storedGame.IsFinished() {
+		emitGameOverEvent(ctx, storedGame)
+	}
+
+	return &types.MsgPlayMovesResponse{
+		Captured: capturedPositions,
+		Winner:   storedGame.Winner,
+	}, nil
+}
@@ -0,0 +1,130 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bekauz/checkers/x/checkers/keeper"
+	testutil "github.com/bekauz/checkers/x/checkers/testutil"
+	"github.com/bekauz/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+// leaves two red pieces that black can capture in a single double jump
+func setupMsgServerWithOneGameForDoubleJump(t testing.TB) (types.MsgServer, keeper.Keeper, context.Context) {
+	msgServer, k, context := setupMsgServerWithOneGameForPlayMove(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	storedGame, _ := k.GetStoredGame(ctx, "1")
+	storedGame.Board = "********|********|*b******|**r*****|********|****r***|********|r*******"
+	k.SetStoredGame(ctx, storedGame)
+	return msgServer, k, context
+}
+
+func TestPlayMovesDoubleJump(t *testing.T) {
+	msgServer, k, context := setupMsgServerWithOneGameForDoubleJump(t)
+
+	response, err := msgServer.PlayMoves(context, &types.MsgPlayMoves{
+		Creator:   testutil.Bob,
+		GameIndex: "1",
+		Path:      []types.Position{{X: 1, Y: 2}, {X: 3, Y: 4}, {X: 5, Y: 6}},
+	})
+
+	require.Nil(t, err)
+	require.EqualValues(t, &types.MsgPlayMovesResponse{
+		Captured: []types.Position{{X: 2, Y: 3}, {X: 4, Y: 5}},
+		Winner:   "*",
+	}, response)
+	storedGame, found := k.GetStoredGame(sdk.UnwrapSDKContext(context), "1")
+	require.True(t, found)
+	require.Equal(t, "********|********|********|********|********|********|*****b**|r*******", storedGame.Board)
+	require.Equal(t, "r", storedGame.Turn)
+	require.EqualValues(t, 1, storedGame.MoveCount)
+	require.Equal(t, []string{"1,2x3,4x5,6"}, storedGame.Moves)
+}
+
+func TestPlayMoveHopsRecordedAsOneTurn(t *testing.T) {
+	msgServer, k, context := setupMsgServerWithOneGameForDoubleJump(t)
+
+	for _, hop := range []*types.MsgPlayMove{
+		{Creator: testutil.Bob, GameIndex: "1", FromX: 1, FromY: 2, ToX: 3, ToY: 4},
+		{Creator: testutil.Bob, GameIndex: "1", FromX: 3, FromY: 4, ToX: 5, ToY: 6},
+		{Creator: testutil.Carol, GameIndex: "1", FromX: 0, FromY: 7, ToX: 1, ToY: 6},
+	} {
+		_, err := msgServer.PlayMove(context, hop)
+		require.Nil(t, err)
+	}
+
+	storedGame, _ := k.GetStoredGame(sdk.UnwrapSDKContext(context), "1")
+	require.Equal(t, []string{"1,2x3,4x5,6", "0,7-1,6"}, storedGame.Moves)
+}
+
+func TestPlayMovesIllegalHopChangesNothing(t *testing.T) {
+	msgServer, k, context := setupMsgServerWithOneGameForDoubleJump(t)
+
+	_, err := msgServer.PlayMoves(context, &types.MsgPlayMoves{
+		Creator:   testutil.Bob,
+		GameIndex: "1",
+		Path:      []types.Position{{X: 1, Y: 2}, {X: 3, Y: 4}, {X: 4, Y: 5}},
+	})
+
+	require.ErrorIs(t, err, types.ErrWrongMove)
+	storedGame, _ := k.GetStoredGame(sdk.UnwrapSDKContext(context), "1")
+	require.Equal(t, "********|********|*b******|**r*****|********|****r***|********|r*******", storedGame.Board)
+	require.Equal(t, "b", storedGame.Turn)
+	require.EqualValues(t, 0, storedGame.MoveCount)
+	require.Empty(t, storedGame.Moves)
+}
+
+func TestPlayMovesNotPlayerTurn(t *testing.T) {
+	msgServer, _, context := setupMsgServerWithOneGameForPlayMove(t)
+
+	_, err := msgServer.PlayMoves(context, &types.MsgPlayMoves{
+		Creator:   testutil.Carol,
+		GameIndex: "1",
+		Path:      []types.Position{{X: 0, Y: 5}, {X: 1, Y: 4}},
+	})
+
+	require.ErrorIs(t, err, types.ErrNotPlayerTurn)
+}
+
+func TestPlayMovesEventEmitted(t *testing.T) {
+	msgServer, _, context := setupMsgServerWithOneGameForDoubleJump(t)
+
+	msgServer.PlayMoves(context, &types.MsgPlayMoves{
+		Creator:   testutil.Bob,
+		GameIndex: "1",
+		Path:      []types.Position{{X: 1, Y: 2}, {X: 3, Y: 4}, {X: 5, Y: 6}},
+	})
+
+	ctx := sdk.UnwrapSDKContext(context)
+	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+	require.Len(t, events, 2) // created and playMoves
+	require.EqualValues(t, sdk.StringEvent{
+		Type: "moves-played",
+		Attributes: []sdk.Attribute{
+			{Key: "creator", Value: testutil.Bob},
+			{Key: "game-index", Value: "1"},
+			{Key: "captured-x", Value: "2"},
+			{Key: "captured-y", Value: "3"},
+			{Key: "captured-x", Value: "4"},
+			{Key: "captured-y", Value: "5"},
+			{Key: "winner", Value: "*"},
+		},
+	}, events[0])
+}
+
+func TestPlayMovesWinnerSaved(t *testing.T) {
+	msgServer, k, context := setupMsgServerWithOneGameCloseToWin(t)
+
+	response, err := msgServer.PlayMoves(context, &types.MsgPlayMoves{
+		Creator:   testutil.Bob,
+		GameIndex: "1",
+		Path:      []types.Position{{X: 1, Y: 2}, {X: 3, Y: 4}},
+	})
+
+	require.Nil(t, err)
+	require.Equal(t, "b", response.Winner)
+	storedGame, _ := k.GetStoredGame(sdk.UnwrapSDKContext(context), "1")
+	require.True(t, storedGame.IsFinished())
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/bekauz/checkers/x/checkers/rules"
+	"github.com/bekauz/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) Resign(goCtx context.Context, msg *types.MsgResign) (*types.MsgResignResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// get the stored game
+	storedGame, found := k.Keeper.GetStoredGame(ctx, msg.GameIndex)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrGameNotFound, "%s", msg.GameIndex)
+	}
+
+	// a game with a winner is locked
+	if storedGame.IsFinished() {
+		return nil, sdkerrors.Wrapf(types.ErrGameFinished, "%s", msg.GameIndex)
+	}
+
+	// determine the resigning color
+	player, found := storedGame.GetPlayerColor(msg.Creator)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrCreatorNotPlayer, "%s", msg.Creator)
+	}
+	storedGame.Winner = rules.PieceStrings[rules.Opponents[player]]
+
+	// a finished game can no longer expire
+	systemInfo, found := k.Keeper.GetSystemInfo(ctx)
+	if !found {
+		panic("SystemInfo not found")
+	}
+	k.Keeper.RemoveFromFifo(ctx, &storedGame, &systemInfo)
+	// nothing is escrowed before the first move
+	if storedGame.BlackPaid || storedGame.RedPaid {
+		k.Keeper.MustPayWinnings(ctx, &storedGame)
+	}
+	k.Keeper.MustRegisterPlayerResign(ctx, &storedGame)
+	k.Keeper.SetStoredGame(ctx, storedGame)
+	k.Keeper.SetSystemInfo(ctx, systemInfo)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.GameResignedEventType,
+		sdk.NewAttribute(types.GameResignedEventCreator, msg.Creator),
+		sdk.NewAttribute(types.GameResignedEventGameIndex, msg.GameIndex),
+		sdk.NewAttribute(types.GameResignedEventWinner, storedGame.Winner),
+		sdk.NewAttribute(types.GameResignedEventBoard, storedGame.Board),
+	))
+
+	return &types.MsgResignResponse{
+		Winner: storedGame.Winner,
+	}, nil
+}
@@ -0,0 +1,193 @@
+package keeper_test
+
+import (
+	"testing"
+
+	keepertest "github.com/bekauz/checkers/testutil/keeper"
+	"github.com/bekauz/checkers/x/checkers"
+	"github.com/bekauz/checkers/x/checkers/keeper"
+	testutil "github.com/bekauz/checkers/x/checkers/testutil"
+	"github.com/bekauz/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResignBlackBeforeAnyMove(t *testing.T) {
+	msgServer, k, context := setupMsgServerWithOneGameForPlayMove(t)
+
+	response, err := msgServer.Resign(context, &types.MsgResign{
+		Creator:   testutil.Bob,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, &types.MsgResignResponse{Winner: "r"}, response)
+
+	ctx := sdk.UnwrapSDKContext(context)
+	storedGame, found := k.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, "r", storedGame.Winner)
+	require.Equal(t, types.NoFifoIndex, storedGame.BeforeIndex)
+	require.Equal(t, types.NoFifoIndex, storedGame.AfterIndex)
+	systemInfo, found := k.GetSystemInfo(ctx)
+	require.True(t, found)
+	require.Equal(t, types.NoFifoIndex, systemInfo.FifoHeadIndex)
+	require.Equal(t, types.NoFifoIndex, systemInfo.FifoTailIndex)
+}
+
+func TestResignRedRegistersStats(t *testing.T) {
+	msgServer, k, context := setupMsgServerWithOneGameForPlayMove(t)
+
+	_, err := msgServer.Resign(context, &types.MsgResign{
+		Creator:   testutil.Carol,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+
+	ctx := sdk.UnwrapSDKContext(context)
+	bobInfo, found := k.GetPlayerInfo(ctx, testutil.Bob)
+	require.True(t, found)
+	require.EqualValues(t, 1, bobInfo.WonCount)
+	carolInfo, found := k.GetPlayerInfo(ctx, testutil.Carol)
+	require.True(t, found)
+	require.EqualValues(t, 1, carolInfo.LostCount)
+	require.EqualValues(t, 1, carolInfo.ResignedCount)
+}
+
+func TestResignNotPlayer(t *testing.T) {
+	msgServer, _, context := setupMsgServerWithOneGameForPlayMove(t)
+
+	_, err := msgServer.Resign(context, &types.MsgResign{
+		Creator:   testutil.Alice,
+		GameIndex: "1",
+	})
+	require.ErrorIs(t, err, types.ErrCreatorNotPlayer)
+}
+
+func TestResignNoGame(t *testing.T) {
+	k, ctx := keepertest.CheckersKeeper(t)
+	checkers.InitGenesis(ctx, *k, *types.DefaultGenesis())
+	msgServer := keeper.NewMsgServerImpl(*k)
+
+	_, err := msgServer.Resign(sdk.WrapSDKContext(ctx), &types.MsgResign{
+		Creator:   testutil.Bob,
+		GameIndex: "1",
+	})
+	require.NotNil(t, err)
+	require.Equal(t, "1: game not found", err.Error())
+}
+
+func TestResignFinishedGame(t *testing.T) {
+	msgServer, _, context := setupMsgServerWithOneGameForPlayMove(t)
+	_, err := msgServer.Resign(context, &types.MsgResign{
+		Creator:   testutil.Bob,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+
+	_, err = msgServer.Resign(context, &types.MsgResign{
+		Creator:   testutil.Carol,
+		GameIndex: "1",
+	})
+	require.ErrorIs(t, err, types.ErrGameFinished)
+}
+
+func TestResignEventEmitted(t *testing.T) {
+	msgServer, _, context := setupMsgServerWithOneGameForPlayMove(t)
+
+	msgServer.Resign(context, &types.MsgResign{
+		Creator:   testutil.Bob,
+		GameIndex: "1",
+	})
+
+	ctx := sdk.UnwrapSDKContext(context)
+	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+	require.Len(t, events, 2) // created and resigned
+	require.EqualValues(t, sdk.StringEvent{
+		Type: "game-resigned",
+		Attributes: []sdk.Attribute{
+			{Key: "creator", Value: testutil.Bob},
+			{Key: "game-index", Value: "1"},
+			{Key: "winner", Value: "r"},
+			{Key: "board", Value: "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*"},
+		},
+	}, events[0])
+}
+
+func TestResignPaysWager(t *testing.T) {
+	msgServer, _, context, bank := setupMsgServerWithOneWagerGame(t)
+	playFirstTwoMoves(t, msgServer, context)
+
+	_, err := msgServer.Resign(context, &types.MsgResign{
+		Creator:   testutil.Bob,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+	require.True(t, bank.ModuleBalance(types.ModuleName).IsZero())
+	require.Equal(t, "55stake", bank.Balances[testutil.Bob].String())
+	require.Equal(t, "145stake", bank.Balances[testutil.Carol].String())
+}
+
+func TestResignBeforeWagerCollected(t *testing.T) {
+	msgServer, _, context, bank := setupMsgServerWithOneWagerGame(t)
+
+	_, err := msgServer.Resign(context, &types.MsgResign{
+		Creator:   testutil.Carol,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+	require.True(t, bank.ModuleBalance(types.ModuleName).IsZero())
+	require.Equal(t, "100stake", bank.Balances[testutil.Bob].String())
+	require.Equal(t, "100stake", bank.Balances[testutil.Carol].String())
+}
+
+func TestResignBlackAfterOneMoveRefundsWager(t *testing.T) {
+	msgServer, _, context, bank := setupMsgServerWithOneWagerGame(t)
+	_, err := msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   testutil.Bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       2,
+		ToY:       3,
+	})
+	require.Nil(t, err)
+	require.Equal(t, "55stake", bank.Balances[testutil.Bob].String())
+
+	response, err := msgServer.Resign(context, &types.MsgResign{
+		Creator:   testutil.Bob,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+	require.Equal(t, "r", response.Winner)
+	require.True(t, bank.ModuleBalance(types.ModuleName).IsZero())
+	require.Equal(t, "100stake", bank.Balances[testutil.Bob].String())
+	require.Equal(t, "100stake", bank.Balances[testutil.Carol].String())
+}
+
+func TestResignRedAfterMovingFirstRefundsWager(t *testing.T) {
+	msgServer, _, context, bank := setupMsgServerWithOneRedFirstWagerGame(t)
+
+	response, err := msgServer.Resign(context, &types.MsgResign{
+		Creator:   testutil.Carol,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+	require.Equal(t, "b", response.Winner)
+	require.True(t, bank.ModuleBalance(types.ModuleName).IsZero())
+	require.Equal(t, "100stake", bank.Balances[testutil.Bob].String())
+	require.Equal(t, "100stake", bank.Balances[testutil.Carol].String())
+}
+
+func TestResignAfterMultiHopTurnRefundsWager(t *testing.T) {
+	msgServer, _, context, bank := setupMsgServerWithOneDoubleJumpWagerGame(t)
+
+	response, err := msgServer.Resign(context, &types.MsgResign{
+		Creator:   testutil.Bob,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+	require.Equal(t, "r", response.Winner)
+	require.True(t, bank.ModuleBalance(types.ModuleName).IsZero())
+	require.Equal(t, "100stake", bank.Balances[testutil.Bob].String())
+	require.Equal(t, "100stake", bank.Balances[testutil.Carol].String())
+}
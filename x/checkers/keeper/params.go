@@ -1,16 +1,54 @@
 package keeper
 
 import (
+	"time"
+
 	"github.com/bekauz/checkers/x/checkers/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // GetParams get all parameters as types.Params
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams()
+	return types.NewParams(
+		k.MaxTurnDuration(ctx),
+		k.EloKFactor(ctx),
+		k.EloStartingRating(ctx),
+		k.DrawRepetitionLimit(ctx),
+		k.DrawNoProgressLimit(ctx),
+	)
 }
 
 // SetParams set the params
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramstore.SetParamSet(ctx, &params)
 }
+
+// MaxTurnDuration returns the MaxTurnDuration param
+func (k Keeper) MaxTurnDuration(ctx sdk.Context) (res time.Duration) {
+	k.paramstore.Get(ctx, types.KeyMaxTurnDuration, &res)
+	return
+}
+
+// EloKFactor returns the EloKFactor param
+func (k Keeper) EloKFactor(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyEloKFactor, &res)
+	return
+}
+
+// EloStartingRating returns the EloStartingRating param
+func (k Keeper) EloStartingRating(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyEloStartingRating, &res)
+	return
+}
+
+// DrawRepetitionLimit returns the DrawRepetitionLimit param
+func (k Keeper) DrawRepetitionLimit(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyDrawRepetitionLimit, &res)
+	return
+}
+
+// DrawNoProgressLimit returns the DrawNoProgressLimit param
+func (k Keeper) DrawNoProgressLimit(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyDrawNoProgressLimit, &res)
+	return
+}
@@ -15,4 +15,9 @@ func TestGetParams(t *testing.T) {
 	k.SetParams(ctx, params)
 
 	require.EqualValues(t, params, k.GetParams(ctx))
+	require.EqualValues(t, params.MaxTurnDuration, k.MaxTurnDuration(ctx))
+	require.EqualValues(t, params.EloKFactor, k.EloKFactor(ctx))
+	require.EqualValues(t, params.EloStartingRating, k.EloStartingRating(ctx))
+	require.EqualValues(t, params.DrawRepetitionLimit, k.DrawRepetitionLimit(ctx))
+	require.EqualValues(t, params.DrawNoProgressLimit, k.DrawNoProgressLimit(ctx))
 }
@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"github.com/bekauz/checkers/x/checkers/rules"
+	"github.com/bekauz/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// getGameToPlay loads an active game and checks that it is the turn of the
+// color played by the creator.
+func (k Keeper) getGameToPlay(ctx sdk.Context, gameIndex string, creator string) (storedGame types.StoredGame, game *rules.Game, player rules.Player, err error) {
+	// get the stored game
+	storedGame, found := k.GetStoredGame(ctx, gameIndex)
+	if !found {
+		return storedGame, nil, player, sdkerrors.Wrapf(types.ErrGameNotFound, "%s", gameIndex)
+	}
+
+	// a game with a winner is locked
+	if storedGame.IsFinished() {
+		return storedGame, nil, player, sdkerrors.Wrapf(types.ErrGameFinished, "%s", gameIndex)
+	}
+
+	// determine player color, only the players can move and pay the wager
+	player, found = storedGame.GetPlayerColor(creator)
+	if !found {
+		return storedGame, nil, player, sdkerrors.Wrapf(types.ErrCreatorNotPlayer, "%s", creator)
+	}
+	// parse the game
+	game, err = storedGame.ParseGame()
+	if err != nil {
+		panic(err.Error())
+	}
+
+	game.DrawLimits = rules.DrawLimits{
+		Repetition: k.DrawRepetitionLimit(ctx),
+		NoProgress: k.DrawNoProgressLimit(ctx),
+	}
+
+	// validate the player turn
+	if !game.TurnIs(player) {
+		return storedGame, nil, player, sdkerrors.Wrapf(types.ErrNotPlayerTurn, "%s", player)
+	}
+	return storedGame, game, player, nil
+}
+
+// mustSavePlayedMove records the path played and stores the position reached
+// after the player moved, and settles the game when the move won or drew it.
+func (k Keeper) mustSavePlayedMove(ctx sdk.Context, storedGame *types.StoredGame, game *rules.Game, player rules.Player, path []rules.Pos, capture bool) {
+	storedGame.RecordMove(path, capture)
+	storedGame.MoveCount++
+	storedGame.Deadline = types.FormatDeadline(types.GetNextDeadline(ctx, k.MaxTurnDuration(ctx)))
+	storedGame.Board = game.String()
+	storedGame.Turn = rules.PieceStrings[game.Turn]
+	storedGame.Winner = rules.PieceStrings[game.Winner()]
+	storedGame.History = game.History
+
+	// moving is an implicit refusal of the opponent's draw offer
+	if storedGame.HasDrawOffer() && storedGame.DrawOffer != rules.PieceStrings[player] {
+		storedGame.DrawOffer = ""
+	}
+
+	// a finished game can no longer expire, an active one goes to the back of the queue
+	systemInfo, found := k.GetSystemInfo(ctx)
+	if !found {
+		panic("SystemInfo not found")
+	}
+	if storedGame.IsDraw() {
+		k.RemoveFromFifo(ctx, storedGame, &systemInfo)
+		k.MustRefundWager(ctx, storedGame)
+		k.MustRegisterPlayerDraw(ctx, storedGame)
+	} else if storedGame.IsFinished() {
+		k.RemoveFromFifo(ctx, storedGame, &systemInfo)
+		k.MustPayWinnings(ctx, storedGame)
+		k.MustRegisterPlayerWin(ctx, storedGame)
+	} else {
+		k.SendToFifoTail(ctx, storedGame, &systemInfo)
+	}
+	k.SetStoredGame(ctx, *storedGame)
+	k.SetSystemInfo(ctx, systemInfo)
+}
+
+func emitGameOverEvent(ctx sdk.Context, storedGame types.StoredGame) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.GameOverEventType,
+		sdk.NewAttribute(types.GameOverEventGameIndex, storedGame.Index),
+		sdk.NewAttribute(types.GameOverEventWinner, storedGame.Winner),
+		sdk.NewAttribute(types.GameOverEventBoard, storedGame.Board),
+	))
+}
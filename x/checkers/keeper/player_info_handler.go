@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/bekauz/checkers/x/checkers/rules"
+	"github.com/bekauz/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func getWinnerAndLoserAddresses(storedGame *types.StoredGame) (winnerAddress sdk.AccAddress, loserAddress sdk.AccAddress) {
+	if !storedGame.IsFinished() {
+		panic(types.ErrThereIsNoWinner.Error())
+	}
+	blackAddress, err := storedGame.GetBlackAddress()
+	if err != nil {
+		panic(err.Error())
+	}
+	redAddress, err := storedGame.GetRedAddress()
+	if err != nil {
+		panic(err.Error())
+	}
+	switch storedGame.Winner {
+	case rules.PieceStrings[rules.BLACK_PLAYER]:
+		return blackAddress, redAddress
+	case rules.PieceStrings[rules.RED_PLAYER]:
+		return redAddress, blackAddress
+	default:
+		panic(fmt.Sprintf(types.ErrWinnerNotParseable.Error(), storedGame.Winner))
+	}
+}
+
+func (k Keeper) getPlayerInfo(ctx sdk.Context, playerAddress sdk.AccAddress) (playerInfo types.PlayerInfo) {
+	playerInfo, found := k.GetPlayerInfo(ctx, playerAddress.String())
+	if !found {
+		playerInfo = types.PlayerInfo{
+			Index:  playerAddress.String(),
+			Rating: k.EloStartingRating(ctx),
+		}
+	}
+	return playerInfo
+}
+
+// mustAddDeltaGameResultToPlayer adds every count of delta to the player's
+// stored counts, and moves their rating by ratingDelta.
+func (k Keeper) mustAddDeltaGameResultToPlayer(ctx sdk.Context, playerAddress sdk.AccAddress, delta types.PlayerInfo, ratingDelta int64) (playerInfo types.PlayerInfo) {
+	playerInfo = k.getPlayerInfo(ctx, playerAddress)
+	playerInfo.Rating = types.ApplyEloRatingDelta(playerInfo.Rating, ratingDelta)
+	playerInfo.WonCount += delta.WonCount
+	playerInfo.LostCount += delta.LostCount
+	playerInfo.ForfeitedCount += delta.ForfeitedCount
+	playerInfo.ResignedCount += delta.ResignedCount
+	playerInfo.DrawnCount += delta.DrawnCount
+	k.SetPlayerInfo(ctx, playerInfo)
+	return playerInfo
+}
+
+// mustRegisterGameResult updates the counts and ratings of both players of a
+// game in which the first one made score. Ratings are read before either is
+// saved, so both deltas cancel out.
+func (k Keeper) mustRegisterGameResult(
+	ctx sdk.Context,
+	playerAddress sdk.AccAddress, playerDelta types.PlayerInfo,
+	opponentAddress sdk.AccAddress, opponentDelta types.PlayerInfo,
+	score uint64,
+) (playerInfo types.PlayerInfo, opponentInfo types.PlayerInfo) {
+	ratingDelta := types.EloRatingDelta(
+		k.getPlayerInfo(ctx, playerAddress).Rating,
+		k.getPlayerInfo(ctx, opponentAddress).Rating,
+		score,
+		k.EloKFactor(ctx),
+	)
+	playerInfo = k.mustAddDeltaGameResultToPlayer(ctx, playerAddress, playerDelta, ratingDelta)
+	opponentInfo = k.mustAddDeltaGameResultToPlayer(ctx, opponentAddress, opponentDelta, -ratingDelta)
+	return playerInfo, opponentInfo
+}
+
+// MustRegisterPlayerWin records a game won by playing.
+func (k Keeper) MustRegisterPlayerWin(ctx sdk.Context, storedGame *types.StoredGame) (winnerInfo types.PlayerInfo, loserInfo types.PlayerInfo) {
+	winnerAddress, loserAddress := getWinnerAndLoserAddresses(storedGame)
+	return k.mustRegisterGameResult(ctx,
+		winnerAddress, types.PlayerInfo{WonCount: 1},
+		loserAddress, types.PlayerInfo{LostCount: 1},
+		types.EloScoreWin)
+}
+
+// MustRegisterPlayerForfeit records a game lost by running out of time.
+func (k Keeper) MustRegisterPlayerForfeit(ctx sdk.Context, storedGame *types.StoredGame) (winnerInfo types.PlayerInfo, forfeiterInfo types.PlayerInfo) {
+	winnerAddress, loserAddress := getWinnerAndLoserAddresses(storedGame)
+	return k.mustRegisterGameResult(ctx,
+		winnerAddress, types.PlayerInfo{WonCount: 1},
+		loserAddress, types.PlayerInfo{LostCount: 1, ForfeitedCount: 1},
+		types.EloScoreWin)
+}
+
+// MustRegisterPlayerResign records a game lost by conceding.
+func (k Keeper) MustRegisterPlayerResign(ctx sdk.Context, storedGame *types.StoredGame) (winnerInfo types.PlayerInfo, resignerInfo types.PlayerInfo) {
+	winnerAddress, loserAddress := getWinnerAndLoserAddresses(storedGame)
+	return k.mustRegisterGameResult(ctx,
+		winnerAddress, types.PlayerInfo{WonCount: 1},
+		loserAddress, types.PlayerInfo{LostCount: 1, ResignedCount: 1},
+		types.EloScoreWin)
+}
+
+// MustRegisterPlayerDraw records a game that ended without a winner.
+func (k Keeper) MustRegisterPlayerDraw(ctx sdk.Context, storedGame *types.StoredGame) (blackInfo types.PlayerInfo, redInfo types.PlayerInfo) {
+	blackAddress, err := storedGame.GetBlackAddress()
+	if err != nil {
+		panic(err.Error())
+	}
+	redAddress, err := storedGame.GetRedAddress()
+	if err != nil {
+		panic(err.Error())
+	}
+	return k.mustRegisterGameResult(ctx,
+		blackAddress, types.PlayerInfo{DrawnCount: 1},
+		redAddress, types.PlayerInfo{DrawnCount: 1},
+		types.EloScoreDraw)
+}
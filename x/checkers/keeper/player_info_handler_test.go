@@ -0,0 +1,120 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	testutil "github.com/bekauz/checkers/x/checkers/testutil"
+	"github.com/bekauz/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlayerInfoNoneBeforeGameOver(t *testing.T) {
+	msgServer, k, context := setupMsgServerWithOneGameForPlayMove(t)
+	msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   testutil.Bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       2,
+		ToY:       3,
+	})
+	require.Empty(t, k.GetAllPlayerInfo(sdk.UnwrapSDKContext(context)))
+}
+
+func TestPlayerInfoWinRegistered(t *testing.T) {
+	msgServer, k, context := setupMsgServerWithOneGameCloseToWin(t)
+	msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   testutil.Bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       3,
+		ToY:       4,
+	})
+
+	ctx := sdk.UnwrapSDKContext(context)
+	bobInfo, found := k.GetPlayerInfo(ctx, testutil.Bob)
+	require.True(t, found)
+	require.EqualValues(t, types.PlayerInfo{Index: testutil.Bob, WonCount: 1, Rating: 1216}, bobInfo)
+	carolInfo, found := k.GetPlayerInfo(ctx, testutil.Carol)
+	require.True(t, found)
+	require.EqualValues(t, types.PlayerInfo{Index: testutil.Carol, LostCount: 1, Rating: 1184}, carolInfo)
+}
+
+func TestPlayerInfoForfeitRegistered(t *testing.T) {
+	msgServer, k, context := setupMsgServerWithOneGameForPlayMove(t)
+	msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   testutil.Bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       2,
+		ToY:       3,
+	})
+	ctx := sdk.UnwrapSDKContext(context)
+	game1, _ := k.GetStoredGame(ctx, "1")
+	game1.Deadline = types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-1)))
+	k.SetStoredGame(ctx, game1)
+	k.ForfeitExpiredGames(context)
+
+	bobInfo, found := k.GetPlayerInfo(ctx, testutil.Bob)
+	require.True(t, found)
+	require.EqualValues(t, types.PlayerInfo{Index: testutil.Bob, WonCount: 1, Rating: 1216}, bobInfo)
+	carolInfo, found := k.GetPlayerInfo(ctx, testutil.Carol)
+	require.True(t, found)
+	require.EqualValues(t, types.PlayerInfo{Index: testutil.Carol, LostCount: 1, ForfeitedCount: 1, Rating: 1184}, carolInfo)
+}
+
+func TestPlayerInfoAddsToExisting(t *testing.T) {
+	_, k, context := setupMsgServerWithOneGameForPlayMove(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	k.SetPlayerInfo(ctx, types.PlayerInfo{Index: testutil.Bob, WonCount: 3, LostCount: 2, DrawnCount: 1, Rating: 1300})
+	game1, _ := k.GetStoredGame(ctx, "1")
+	game1.Winner = "r"
+
+	winnerInfo, resignerInfo := k.MustRegisterPlayerResign(ctx, &game1)
+
+	require.EqualValues(t, types.PlayerInfo{Index: testutil.Carol, WonCount: 1, Rating: 1220}, winnerInfo)
+	require.EqualValues(t, types.PlayerInfo{Index: testutil.Bob, WonCount: 3, LostCount: 3, ResignedCount: 1, DrawnCount: 1, Rating: 1280}, resignerInfo)
+	bobInfo, _ := k.GetPlayerInfo(ctx, testutil.Bob)
+	require.EqualValues(t, resignerInfo, bobInfo)
+}
+
+func TestPlayerInfoDrawRegistered(t *testing.T) {
+	_, k, context := setupMsgServerWithOneGameForPlayMove(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	game1, _ := k.GetStoredGame(ctx, "1")
+
+	blackInfo, redInfo := k.MustRegisterPlayerDraw(ctx, &game1)
+
+	require.EqualValues(t, types.PlayerInfo{Index: testutil.Bob, DrawnCount: 1, Rating: 1200}, blackInfo)
+	require.EqualValues(t, types.PlayerInfo{Index: testutil.Carol, DrawnCount: 1, Rating: 1200}, redInfo)
+}
+
+func TestPlayerInfoWinPanicsWithoutWinner(t *testing.T) {
+	_, k, context := setupMsgServerWithOneGameForPlayMove(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	game1, _ := k.GetStoredGame(ctx, "1")
+
+	defer func() {
+		r := recover()
+		require.NotNil(t, r, "The registration did not panic")
+		require.Equal(t, "there is no winner", r)
+	}()
+	k.MustRegisterPlayerWin(ctx, &game1)
+}
+
+func TestPlayerInfoSelfPlayKeepsRating(t *testing.T) {
+	_, k, context := setupMsgServerWithOneGameForPlayMove(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	game1, _ := k.GetStoredGame(ctx, "1")
+	game1.Red = game1.Black
+	game1.Winner = "b"
+
+	k.MustRegisterPlayerWin(ctx, &game1)
+
+	bobInfo, _ := k.GetPlayerInfo(ctx, testutil.Bob)
+	require.EqualValues(t, types.PlayerInfo{Index: testutil.Bob, WonCount: 1, LostCount: 1, Rating: 1200}, bobInfo)
+}
@@ -22,14 +22,25 @@ func (k Keeper) StoredGameAll(goCtx context.Context, req *types.QueryAllStoredGa
 	store := ctx.KVStore(k.storeKey)
 	storedGameStore := prefix.NewStore(store, types.KeyPrefix(types.StoredGameKeyPrefix))
 
-	pageRes, err := query.Paginate(storedGameStore, req.Pagination, func(key []byte, value []byte) error {
+	// reject unknown statuses before touching the store
+	if _, err := (types.StoredGame{}).HasStatus(req.Status); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	pageRes, err := query.FilteredPaginate(storedGameStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
 		var storedGame types.StoredGame
 		if err := k.cdc.Unmarshal(value, &storedGame); err != nil {
-			return err
+			return false, err
 		}
 
-		storedGames = append(storedGames, storedGame)
-		return nil
+		matches, err := storedGame.HasStatus(req.Status)
+		if err != nil {
+			return false, err
+		}
+		if matches && accumulate {
+			storedGames = append(storedGames, storedGame)
+		}
+		return matches, nil
 	})
 
 	if err != nil {
@@ -124,3 +124,52 @@ func TestStoredGameQueryPaginated(t *testing.T) {
 		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
 	})
 }
+
+func TestStoredGameQueryByStatus(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNStoredGame(keeper, ctx, 4)
+	msgs[1].Winner = "b"
+	keeper.SetStoredGame(ctx, msgs[1])
+	msgs[2].Winner = "*"
+	keeper.SetStoredGame(ctx, msgs[2])
+
+	for _, tc := range []struct {
+		desc   string
+		status string
+		games  []types.StoredGame
+	}{
+		{
+			desc:   "All",
+			status: "",
+			games:  msgs,
+		},
+		{
+			desc:   "Active",
+			status: types.GameStatusActive,
+			games:  []types.StoredGame{msgs[0], msgs[2], msgs[3]},
+		},
+		{
+			desc:   "Finished",
+			status: types.GameStatusFinished,
+			games:  []types.StoredGame{msgs[1]},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			resp, err := keeper.StoredGameAll(wctx, &types.QueryAllStoredGameRequest{
+				Pagination: &query.PageRequest{CountTotal: true},
+				Status:     tc.status,
+			})
+			require.NoError(t, err)
+			require.Equal(t, len(tc.games), int(resp.Pagination.Total))
+			require.ElementsMatch(t,
+				nullify.Fill(tc.games),
+				nullify.Fill(resp.StoredGame),
+			)
+		})
+	}
+	t.Run("InvalidStatus", func(t *testing.T) {
+		_, err := keeper.StoredGameAll(wctx, &types.QueryAllStoredGameRequest{Status: "paused"})
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "paused: game status is invalid"))
+	})
+}
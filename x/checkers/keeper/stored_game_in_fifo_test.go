@@ -0,0 +1,154 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/bekauz/checkers/x/checkers/keeper"
+	testutil "github.com/bekauz/checkers/x/checkers/testutil"
+	"github.com/bekauz/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func setupKeeperWithThreeQueuedGames(t testing.TB) (types.MsgServer, keeper.Keeper, sdk.Context) {
+	msgServer, k, context := setupMsgServerCreateGame(t)
+	for _, black := range []string{testutil.Alice, testutil.Bob, testutil.Carol} {
+		_, err := msgServer.CreateGame(context, &types.MsgCreateGame{
+			Creator: testutil.Alice,
+			Black:   black,
+			Red:     testutil.Alice,
+		})
+		require.Nil(t, err)
+	}
+	return msgServer, k, sdk.UnwrapSDKContext(context)
+}
+
+// walks the FIFO from head to tail, checking the invariant along the way
+func requireFifoOrder(t *testing.T, k keeper.Keeper, ctx sdk.Context, expected ...string) {
+	msg, broken := keeper.FifoInvariant(k)(ctx)
+	require.False(t, broken, msg)
+	systemInfo, found := k.GetSystemInfo(ctx)
+	require.True(t, found)
+	var actual []string
+	for id := systemInfo.FifoHeadIndex; id != types.NoFifoIndex; {
+		actual = append(actual, id)
+		game, found := k.GetStoredGame(ctx, id)
+		require.True(t, found)
+		id = game.AfterIndex
+	}
+	require.Equal(t, expected, actual)
+}
+
+func TestFifoCreateAppendsToTail(t *testing.T) {
+	_, k, ctx := setupKeeperWithThreeQueuedGames(t)
+	requireFifoOrder(t, k, ctx, "1", "2", "3")
+}
+
+func TestFifoSendHeadToTail(t *testing.T) {
+	_, k, ctx := setupKeeperWithThreeQueuedGames(t)
+	systemInfo, _ := k.GetSystemInfo(ctx)
+	game1, _ := k.GetStoredGame(ctx, "1")
+	k.SendToFifoTail(ctx, &game1, &systemInfo)
+	k.SetStoredGame(ctx, game1)
+	k.SetSystemInfo(ctx, systemInfo)
+	requireFifoOrder(t, k, ctx, "2", "3", "1")
+}
+
+func TestFifoSendMiddleToTail(t *testing.T) {
+	_, k, ctx := setupKeeperWithThreeQueuedGames(t)
+	systemInfo, _ := k.GetSystemInfo(ctx)
+	game2, _ := k.GetStoredGame(ctx, "2")
+	k.SendToFifoTail(ctx, &game2, &systemInfo)
+	k.SetStoredGame(ctx, game2)
+	k.SetSystemInfo(ctx, systemInfo)
+	requireFifoOrder(t, k, ctx, "1", "3", "2")
+}
+
+func TestFifoSendTailToTail(t *testing.T) {
+	_, k, ctx := setupKeeperWithThreeQueuedGames(t)
+	systemInfo, _ := k.GetSystemInfo(ctx)
+	game3, _ := k.GetStoredGame(ctx, "3")
+	k.SendToFifoTail(ctx, &game3, &systemInfo)
+	k.SetStoredGame(ctx, game3)
+	k.SetSystemInfo(ctx, systemInfo)
+	requireFifoOrder(t, k, ctx, "1", "2", "3")
+}
+
+func TestFifoRemoveEach(t *testing.T) {
+	for _, tc := range []struct {
+		removed  string
+		expected []string
+	}{
+		{removed: "1", expected: []string{"2", "3"}},
+		{removed: "2", expected: []string{"1", "3"}},
+		{removed: "3", expected: []string{"1", "2"}},
+	} {
+		t.Run(tc.removed, func(t *testing.T) {
+			_, k, ctx := setupKeeperWithThreeQueuedGames(t)
+			systemInfo, _ := k.GetSystemInfo(ctx)
+			game, _ := k.GetStoredGame(ctx, tc.removed)
+			k.RemoveFromFifo(ctx, &game, &systemInfo)
+			k.SetStoredGame(ctx, game)
+			k.SetSystemInfo(ctx, systemInfo)
+			require.Equal(t, types.NoFifoIndex, game.BeforeIndex)
+			require.Equal(t, types.NoFifoIndex, game.AfterIndex)
+			requireFifoOrder(t, k, ctx, tc.expected...)
+		})
+	}
+}
+
+func TestFifoRemoveAllThenRequeue(t *testing.T) {
+	_, k, ctx := setupKeeperWithThreeQueuedGames(t)
+	systemInfo, _ := k.GetSystemInfo(ctx)
+	for _, id := range []string{"2", "1", "3"} {
+		game, _ := k.GetStoredGame(ctx, id)
+		k.RemoveFromFifo(ctx, &game, &systemInfo)
+		k.SetStoredGame(ctx, game)
+	}
+	k.SetSystemInfo(ctx, systemInfo)
+	require.Equal(t, types.NoFifoIndex, systemInfo.FifoHeadIndex)
+	require.Equal(t, types.NoFifoIndex, systemInfo.FifoTailIndex)
+	requireFifoOrder(t, k, ctx)
+
+	game2, _ := k.GetStoredGame(ctx, "2")
+	k.SendToFifoTail(ctx, &game2, &systemInfo)
+	k.SetStoredGame(ctx, game2)
+	k.SetSystemInfo(ctx, systemInfo)
+	requireFifoOrder(t, k, ctx, "2")
+}
+
+func TestFifoPlayMoveRequeues(t *testing.T) {
+	msgServer, k, ctx := setupKeeperWithThreeQueuedGames(t)
+	_, err := msgServer.PlayMove(sdk.WrapSDKContext(ctx), &types.MsgPlayMove{
+		Creator:   testutil.Bob,
+		GameIndex: "2",
+		FromX:     1,
+		FromY:     2,
+		ToX:       2,
+		ToY:       3,
+	})
+	require.Nil(t, err)
+	requireFifoOrder(t, k, ctx, "1", "3", "2")
+}
+
+func TestFifoInvariantBroken(t *testing.T) {
+	_, k, ctx := setupKeeperWithThreeQueuedGames(t)
+	game2, _ := k.GetStoredGame(ctx, "2")
+	game2.BeforeIndex = "3"
+	k.SetStoredGame(ctx, game2)
+
+	msg, broken := keeper.FifoInvariant(k)(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "Fifo game 2 points back to 3 instead of 1")
+}
+
+func TestFifoInvariantFinishedGameQueued(t *testing.T) {
+	_, k, ctx := setupKeeperWithThreeQueuedGames(t)
+	game3, _ := k.GetStoredGame(ctx, "3")
+	game3.Winner = "b"
+	k.SetStoredGame(ctx, game3)
+
+	msg, broken := keeper.FifoInvariant(k)(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "Fifo game 3 is already finished")
+}
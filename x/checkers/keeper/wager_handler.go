@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/bekauz/checkers/x/checkers/rules"
+	"github.com/bekauz/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CollectWager escrows the wager of the player about to move, if this is its
+// first move. Returns an error if the player has not enough funds.
+func (k Keeper) CollectWager(ctx sdk.Context, storedGame *types.StoredGame, player rules.Player) error {
+	if !storedGame.HasWager() || storedGame.HasPaidWager(player) {
+		// There is nothing to pay, or the player has already paid
+		return nil
+	}
+	payer, cannotPay := storedGame.GetBlackAddress, types.ErrBlackCannotPay
+	if player == rules.RED_PLAYER {
+		payer, cannotPay = storedGame.GetRedAddress, types.ErrRedCannotPay
+	}
+	address, err := payer()
+	if err != nil {
+		panic(err.Error())
+	}
+	err = k.bank.SendCoinsFromAccountToModule(ctx, address, types.ModuleName, sdk.NewCoins(storedGame.GetWagerCoin()))
+	if err != nil {
+		return sdkerrors.Wrapf(cannotPay, "%s", err)
+	}
+	storedGame.SetWagerPaid(player)
+	return nil
+}
+
+// MustPayWinnings sends the pot to the winner. If only one player has paid,
+// that player only gets its own wager back. Panics when the escrow cannot pay.
+func (k Keeper) MustPayWinnings(ctx sdk.Context, storedGame *types.StoredGame) {
+	if !storedGame.HasWager() {
+		return
+	}
+	blackPaid, redPaid := storedGame.BlackPaid, storedGame.RedPaid
+	if !blackPaid && !redPaid {
+		panic(types.ErrNothingToPay.Error())
+	} else if !blackPaid || !redPaid {
+		k.MustRefundWager(ctx, storedGame)
+		return
+	}
+	winnerAddress, found, err := storedGame.GetWinnerAddress()
+	if err != nil {
+		panic(err.Error())
+	}
+	if !found {
+		panic(fmt.Sprintf(types.ErrCannotFindWinner.Error(), storedGame.Winner))
+	}
+	winnings := storedGame.GetWagerCoin()
+	winnings = winnings.Add(winnings)
+	err = k.bank.SendCoinsFromModuleToAccount(ctx, types.ModuleName, winnerAddress, sdk.NewCoins(winnings))
+	if err != nil {
+		panic(fmt.Sprintf(types.ErrCannotPayWinnings.Error(), err.Error()))
+	}
+}
+
+// MustRefundWager gives each player back the wager they have paid, for games
+// that end without a winner. Panics when the escrow cannot pay.
+func (k Keeper) MustRefundWager(ctx sdk.Context, storedGame *types.StoredGame) {
+	if !storedGame.HasWager() {
+		return
+	}
+	blackPaid, redPaid := storedGame.BlackPaid, storedGame.RedPaid
+	refunds := []struct {
+		paid   bool
+		player func() (sdk.AccAddress, error)
+	}{
+		{paid: blackPaid, player: storedGame.GetBlackAddress},
+		{paid: redPaid, player: storedGame.GetRedAddress},
+	}
+	for _, refund := range refunds {
+		if !refund.paid {
+			continue
+		}
+		address, err := refund.player()
+		if err != nil {
+			panic(err.Error())
+		}
+		err = k.bank.SendCoinsFromModuleToAccount(ctx, types.ModuleName, address, sdk.NewCoins(storedGame.GetWagerCoin()))
+		if err != nil {
+			panic(fmt.Sprintf(types.ErrCannotRefundWager.Error(), address.String()))
+		}
+	}
+}
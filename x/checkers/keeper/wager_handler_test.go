@@ -0,0 +1,331 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	keepertest "github.com/bekauz/checkers/testutil/keeper"
+	"github.com/bekauz/checkers/x/checkers"
+	"github.com/bekauz/checkers/x/checkers/keeper"
+	testutil "github.com/bekauz/checkers/x/checkers/testutil"
+	"github.com/bekauz/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func setupMsgServerWithOneWagerGame(t testing.TB) (types.MsgServer, keeper.Keeper, context.Context, *testutil.BankKeeper) {
+	bank := testutil.NewBankKeeper()
+	bank.Fund(testutil.Bob, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	bank.Fund(testutil.Carol, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	k, ctx := keepertest.CheckersKeeperWithBank(t, bank)
+	checkers.InitGenesis(ctx, *k, *types.DefaultGenesis())
+	server := keeper.NewMsgServerImpl(*k)
+	context := sdk.WrapSDKContext(ctx)
+	server.CreateGame(context, &types.MsgCreateGame{
+		Creator: testutil.Alice,
+		Black:   testutil.Bob,
+		Red:     testutil.Carol,
+		Wager:   45,
+		Denom:   "stake",
+	})
+	return server, *k, context, bank
+}
+
+func playFirstTwoMoves(t testing.TB, msgServer types.MsgServer, context context.Context) {
+	_, err := msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   testutil.Bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       2,
+		ToY:       3,
+	})
+	require.Nil(t, err)
+	_, err = msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   testutil.Carol,
+		GameIndex: "1",
+		FromX:     0,
+		FromY:     5,
+		ToX:       1,
+		ToY:       4,
+	})
+	require.Nil(t, err)
+}
+
+func TestCreateGameSavesWager(t *testing.T) {
+	_, k, context, bank := setupMsgServerWithOneWagerGame(t)
+	storedGame, found := k.GetStoredGame(sdk.UnwrapSDKContext(context), "1")
+	require.True(t, found)
+	require.EqualValues(t, 45, storedGame.Wager)
+	require.Equal(t, "stake", storedGame.Denom)
+	// nothing is collected before the first move
+	require.True(t, bank.ModuleBalance(types.ModuleName).IsZero())
+}
+
+func TestWagerCollectedOnFirstMoves(t *testing.T) {
+	msgServer, _, context, bank := setupMsgServerWithOneWagerGame(t)
+	playFirstTwoMoves(t, msgServer, context)
+
+	require.Equal(t, "90stake", bank.ModuleBalance(types.ModuleName).String())
+	require.Equal(t, "55stake", bank.Balances[testutil.Bob].String())
+	require.Equal(t, "55stake", bank.Balances[testutil.Carol].String())
+
+	// the third move collects nothing more
+	_, err := msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   testutil.Bob,
+		GameIndex: "1",
+		FromX:     2,
+		FromY:     3,
+		ToX:       0,
+		ToY:       5,
+	})
+	require.Nil(t, err)
+	require.Equal(t, "90stake", bank.ModuleBalance(types.ModuleName).String())
+}
+
+func TestWagerBlackCannotPay(t *testing.T) {
+	msgServer, _, context, bank := setupMsgServerWithOneWagerGame(t)
+	bank.Balances[testutil.Bob] = sdk.NewCoins(sdk.NewInt64Coin("stake", 44))
+
+	_, err := msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   testutil.Bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       2,
+		ToY:       3,
+	})
+	require.ErrorIs(t, err, types.ErrBlackCannotPay)
+}
+
+func TestWagerNotCollectedByNonPlayer(t *testing.T) {
+	msgServer, _, context, bank := setupMsgServerWithOneWagerGame(t)
+
+	_, err := msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   testutil.Alice,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       2,
+		ToY:       3,
+	})
+	require.ErrorIs(t, err, types.ErrCreatorNotPlayer)
+	require.True(t, bank.ModuleBalance(types.ModuleName).IsZero())
+	require.Equal(t, "100stake", bank.Balances[testutil.Bob].String())
+}
+
+func TestWagerPaidToWinner(t *testing.T) {
+	msgServer, k, context, bank := setupMsgServerWithOneWagerGame(t)
+	playFirstTwoMoves(t, msgServer, context)
+	ctx := sdk.UnwrapSDKContext(context)
+	storedGame, _ := k.GetStoredGame(ctx, "1")
+	storedGame.Board = "********|********|********|********|*b******|**r*****|********|********"
+	storedGame.Turn = "b"
+	k.SetStoredGame(ctx, storedGame)
+
+	_, err := msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   testutil.Bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     4,
+		ToX:       3,
+		ToY:       6,
+	})
+	require.Nil(t, err)
+	require.True(t, bank.ModuleBalance(types.ModuleName).IsZero())
+	require.Equal(t, "145stake", bank.Balances[testutil.Bob].String())
+	require.Equal(t, "55stake", bank.Balances[testutil.Carol].String())
+}
+
+func TestWagerPaidOnForfeit(t *testing.T) {
+	msgServer, k, context, bank := setupMsgServerWithOneWagerGame(t)
+	playFirstTwoMoves(t, msgServer, context)
+	ctx := sdk.UnwrapSDKContext(context)
+	storedGame, _ := k.GetStoredGame(ctx, "1")
+	storedGame.Deadline = types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-1)))
+	k.SetStoredGame(ctx, storedGame)
+
+	k.ForfeitExpiredGames(context)
+
+	// black is to play, red wins the pot
+	require.True(t, bank.ModuleBalance(types.ModuleName).IsZero())
+	require.Equal(t, "55stake", bank.Balances[testutil.Bob].String())
+	require.Equal(t, "145stake", bank.Balances[testutil.Carol].String())
+}
+
+func TestWagerRefundedToPayers(t *testing.T) {
+	msgServer, k, context, bank := setupMsgServerWithOneWagerGame(t)
+	playFirstTwoMoves(t, msgServer, context)
+	ctx := sdk.UnwrapSDKContext(context)
+	storedGame, _ := k.GetStoredGame(ctx, "1")
+
+	k.MustRefundWager(ctx, &storedGame)
+
+	require.True(t, bank.ModuleBalance(types.ModuleName).IsZero())
+	require.Equal(t, "100stake", bank.Balances[testutil.Bob].String())
+	require.Equal(t, "100stake", bank.Balances[testutil.Carol].String())
+}
+
+func TestWagerRefundPanicsWhenEscrowEmpty(t *testing.T) {
+	msgServer, k, context, bank := setupMsgServerWithOneWagerGame(t)
+	playFirstTwoMoves(t, msgServer, context)
+	ctx := sdk.UnwrapSDKContext(context)
+	storedGame, _ := k.GetStoredGame(ctx, "1")
+	bank.Balances = map[string]sdk.Coins{}
+
+	defer func() {
+		r := recover()
+		require.NotNil(t, r, "The refund did not panic")
+		require.Equal(t, "cannot refund wager to: "+testutil.Bob, r)
+	}()
+	k.MustRefundWager(ctx, &storedGame)
+}
+
+func setupMsgServerWithOneRedFirstWagerGame(t testing.TB) (types.MsgServer, keeper.Keeper, context.Context, *testutil.BankKeeper) {
+	bank := testutil.NewBankKeeper()
+	bank.Fund(testutil.Bob, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	bank.Fund(testutil.Carol, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	k, ctx := keepertest.CheckersKeeperWithBank(t, bank)
+	checkers.InitGenesis(ctx, *k, *types.DefaultGenesis())
+	server := keeper.NewMsgServerImpl(*k)
+	context := sdk.WrapSDKContext(ctx)
+	server.CreateGame(context, &types.MsgCreateGame{
+		Creator: testutil.Alice,
+		Black:   testutil.Bob,
+		Red:     testutil.Carol,
+		Wager:   45,
+		Denom:   "stake",
+		Board:   "W:W18,K31:B1,14",
+	})
+	_, err := server.PlayMove(context, &types.MsgPlayMove{
+		Creator:   testutil.Carol,
+		GameIndex: "1",
+		FromX:     3,
+		FromY:     4,
+		ToX:       1,
+		ToY:       2,
+	})
+	require.Nil(t, err)
+	return server, *k, context, bank
+}
+
+func TestWagerCollectedFromRedMovingFirst(t *testing.T) {
+	msgServer, _, context, bank := setupMsgServerWithOneRedFirstWagerGame(t)
+	require.Equal(t, "45stake", bank.ModuleBalance(types.ModuleName).String())
+	require.Equal(t, "100stake", bank.Balances[testutil.Bob].String())
+	require.Equal(t, "55stake", bank.Balances[testutil.Carol].String())
+
+	_, err := msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   testutil.Bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     0,
+		ToX:       0,
+		ToY:       1,
+	})
+	require.Nil(t, err)
+	require.Equal(t, "90stake", bank.ModuleBalance(types.ModuleName).String())
+	require.Equal(t, "55stake", bank.Balances[testutil.Bob].String())
+	require.Equal(t, "55stake", bank.Balances[testutil.Carol].String())
+}
+
+func TestWagerRefundedToRedMovingFirst(t *testing.T) {
+	_, k, context, bank := setupMsgServerWithOneRedFirstWagerGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	storedGame, _ := k.GetStoredGame(ctx, "1")
+
+	k.MustRefundWager(ctx, &storedGame)
+
+	require.True(t, bank.ModuleBalance(types.ModuleName).IsZero())
+	require.Equal(t, "100stake", bank.Balances[testutil.Bob].String())
+	require.Equal(t, "100stake", bank.Balances[testutil.Carol].String())
+}
+
+func TestWagerReturnedOnForfeitBeforeBlackPaid(t *testing.T) {
+	_, k, context, bank := setupMsgServerWithOneRedFirstWagerGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	storedGame, _ := k.GetStoredGame(ctx, "1")
+	storedGame.Deadline = types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-1)))
+	k.SetStoredGame(ctx, storedGame)
+
+	k.ForfeitExpiredGames(context)
+
+	// black is to play and loses, red only gets its own wager back
+	storedGame, _ = k.GetStoredGame(ctx, "1")
+	require.Equal(t, "r", storedGame.Winner)
+	require.True(t, bank.ModuleBalance(types.ModuleName).IsZero())
+	require.Equal(t, "100stake", bank.Balances[testutil.Bob].String())
+	require.Equal(t, "100stake", bank.Balances[testutil.Carol].String())
+}
+
+func setupMsgServerWithOneDoubleJumpWagerGame(t testing.TB) (types.MsgServer, keeper.Keeper, context.Context, *testutil.BankKeeper) {
+	bank := testutil.NewBankKeeper()
+	bank.Fund(testutil.Bob, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	bank.Fund(testutil.Carol, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	k, ctx := keepertest.CheckersKeeperWithBank(t, bank)
+	checkers.InitGenesis(ctx, *k, *types.DefaultGenesis())
+	server := keeper.NewMsgServerImpl(*k)
+	context := sdk.WrapSDKContext(ctx)
+	server.CreateGame(context, &types.MsgCreateGame{
+		Creator: testutil.Alice,
+		Black:   testutil.Bob,
+		Red:     testutil.Carol,
+		Wager:   45,
+		Denom:   "stake",
+		Board:   "********|********|*b******|**r*****|********|****r***|********|r*******",
+	})
+	// black captures twice in a single turn, with one message per hop
+	for _, hop := range []*types.MsgPlayMove{
+		{Creator: testutil.Bob, GameIndex: "1", FromX: 1, FromY: 2, ToX: 3, ToY: 4},
+		{Creator: testutil.Bob, GameIndex: "1", FromX: 3, FromY: 4, ToX: 5, ToY: 6},
+	} {
+		_, err := server.PlayMove(context, hop)
+		require.Nil(t, err)
+	}
+	return server, *k, context, bank
+}
+
+func TestWagerCollectedOnceOverMultiHopTurn(t *testing.T) {
+	msgServer, k, context, bank := setupMsgServerWithOneDoubleJumpWagerGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	storedGame, _ := k.GetStoredGame(ctx, "1")
+	require.EqualValues(t, 2, storedGame.MoveCount)
+	require.True(t, storedGame.BlackPaid)
+	require.False(t, storedGame.RedPaid)
+	require.Equal(t, "45stake", bank.ModuleBalance(types.ModuleName).String())
+	require.Equal(t, "55stake", bank.Balances[testutil.Bob].String())
+	require.Equal(t, "100stake", bank.Balances[testutil.Carol].String())
+
+	// red still pays on its first move
+	_, err := msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   testutil.Carol,
+		GameIndex: "1",
+		FromX:     0,
+		FromY:     7,
+		ToX:       1,
+		ToY:       6,
+	})
+	require.Nil(t, err)
+	storedGame, _ = k.GetStoredGame(ctx, "1")
+	require.True(t, storedGame.RedPaid)
+	require.Equal(t, "90stake", bank.ModuleBalance(types.ModuleName).String())
+	require.Equal(t, "55stake", bank.Balances[testutil.Carol].String())
+}
+
+func TestWagerReturnedOnForfeitAfterMultiHopTurn(t *testing.T) {
+	_, k, context, bank := setupMsgServerWithOneDoubleJumpWagerGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	storedGame, _ := k.GetStoredGame(ctx, "1")
+	storedGame.Deadline = types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-1)))
+	k.SetStoredGame(ctx, storedGame)
+
+	k.ForfeitExpiredGames(context)
+
+	// red is to play and loses, black only gets its own wager back
+	storedGame, _ = k.GetStoredGame(ctx, "1")
+	require.Equal(t, "b", storedGame.Winner)
+	require.True(t, bank.ModuleBalance(types.ModuleName).IsZero())
+	require.Equal(t, "100stake", bank.Balances[testutil.Bob].String())
+	require.Equal(t, "100stake", bank.Balances[testutil.Carol].String())
+}
@@ -32,6 +32,26 @@ const (
 	// TODO: Determine the simulation weight value
 	defaultWeightMsgPlayMove int = 100
 
+	opWeightMsgPlayMoves = "op_weight_msg_play_moves"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgPlayMoves int = 100
+
+	opWeightMsgResign = "op_weight_msg_resign"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgResign int = 100
+
+	opWeightMsgOfferDraw = "op_weight_msg_offer_draw"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgOfferDraw int = 100
+
+	opWeightMsgAcceptDraw = "op_weight_msg_accept_draw"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgAcceptDraw int = 100
+
+	opWeightMsgDeclineDraw = "op_weight_msg_decline_draw"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgDeclineDraw int = 100
+
 	// this line is used by starport scaffolding # simapp/module/const
 )
 
@@ -88,6 +108,61 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		checkerssimulation.SimulateMsgPlayMove(am.accountKeeper, am.bankKeeper, am.keeper),
 	))
 
+	var weightMsgPlayMoves int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgPlayMoves, &weightMsgPlayMoves, nil,
+		func(_ *rand.Rand) {
+			weightMsgPlayMoves = defaultWeightMsgPlayMoves
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgPlayMoves,
+		checkerssimulation.SimulateMsgPlayMoves(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgResign int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgResign, &weightMsgResign, nil,
+		func(_ *rand.Rand) {
+			weightMsgResign = defaultWeightMsgResign
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgResign,
+		checkerssimulation.SimulateMsgResign(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgOfferDraw int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgOfferDraw, &weightMsgOfferDraw, nil,
+		func(_ *rand.Rand) {
+			weightMsgOfferDraw = defaultWeightMsgOfferDraw
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgOfferDraw,
+		checkerssimulation.SimulateMsgOfferDraw(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgAcceptDraw int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgAcceptDraw, &weightMsgAcceptDraw, nil,
+		func(_ *rand.Rand) {
+			weightMsgAcceptDraw = defaultWeightMsgAcceptDraw
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgAcceptDraw,
+		checkerssimulation.SimulateMsgAcceptDraw(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgDeclineDraw int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgDeclineDraw, &weightMsgDeclineDraw, nil,
+		func(_ *rand.Rand) {
+			weightMsgDeclineDraw = defaultWeightMsgDeclineDraw
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgDeclineDraw,
+		checkerssimulation.SimulateMsgDeclineDraw(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
 	// this line is used by starport scaffolding # simapp/module/operation
 
 	return operations
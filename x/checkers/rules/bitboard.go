@@ -0,0 +1,265 @@
+package rules
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/bits"
+	"sort"
+	"strings"
+)
+
+// Bitboard packs a position of the 32 dark squares of an 8x8 board into
+// masks, bit n standing for square n+1, that is for Pos{2*(n%4)+1-(n/4)%2, n/4}.
+// Moves and jumps of all pieces are then found with a few shifts, in the way
+// of English draughts. A Game of a rule set with Bitboards keeps one next to
+// its Pieces to tell whether a player can move or capture.
+type Bitboard struct {
+	Black uint32
+	Red   uint32
+	Kings uint32
+}
+
+const (
+	BITBOARD_DIM = 8
+
+	bitboardSquares = 32
+	bitboardEven    = uint32(0x0F0F0F0F) // rows 0, 2, 4 and 6, starting on x == 1
+	bitboardOdd     = uint32(0xF0F0F0F0) // rows 1, 3, 5 and 7, starting on x == 0
+	bitboardLeft    = uint32(0x11111111) // the first square of each row
+	bitboardRight   = uint32(0x88888888) // the last square of each row
+)
+
+// bitDirection is a diagonal as seen in bit indices: a step adds evenStep to
+// the index of a square on an even row and oddStep on an odd row, while a
+// jump adds both. The edges hold the squares that have no step, or no jump,
+// sideways in that direction.
+type bitDirection struct {
+	evenStep  int
+	oddStep   int
+	stepEdge  uint32
+	jumpEdge  uint32
+	forwardOf Player
+}
+
+var bitDirections = []bitDirection{
+	{evenStep: 4, oddStep: 3, stepEdge: bitboardOdd & bitboardLeft, jumpEdge: bitboardLeft, forwardOf: BLACK_PLAYER},
+	{evenStep: 5, oddStep: 4, stepEdge: bitboardEven & bitboardRight, jumpEdge: bitboardRight, forwardOf: BLACK_PLAYER},
+	{evenStep: -4, oddStep: -5, stepEdge: bitboardOdd & bitboardLeft, jumpEdge: bitboardLeft, forwardOf: RED_PLAYER},
+	{evenStep: -3, oddStep: -4, stepEdge: bitboardEven & bitboardRight, jumpEdge: bitboardRight, forwardOf: RED_PLAYER},
+}
+
+// towards moves the bits of mask so that bit i tells about square i+delta.
+func towards(mask uint32, delta int) uint32 {
+	if delta < 0 {
+		return mask << -delta
+	}
+	return mask >> delta
+}
+
+func bitOf(pos Pos) (uint32, bool) {
+	if pos.X < 0 || BITBOARD_DIM <= pos.X || pos.Y < 0 || BITBOARD_DIM <= pos.Y || (pos.X+pos.Y)%2 != 1 {
+		return 0, false
+	}
+	return 1 << (pos.Y*BITBOARD_DIM/2 + pos.X/2), true
+}
+
+func posOfBit(index int) Pos {
+	y := index / (BITBOARD_DIM / 2)
+	return Pos{2*(index%(BITBOARD_DIM/2)) + (y+1)%2, y}
+}
+
+// NewBitboard packs the pieces of a game, which must all stand on the dark
+// squares of an 8x8 board.
+func NewBitboard(pieces map[Pos]Piece) (bitboard Bitboard, err error) {
+	for pos, piece := range pieces {
+		bit, ok := bitOf(pos)
+		if !ok {
+			return Bitboard{}, errors.New(fmt.Sprintf("position not on a bitboard: %v", pos))
+		}
+		if piece.Player == BLACK_PLAYER {
+			bitboard.Black |= bit
+		} else if piece.Player == RED_PLAYER {
+			bitboard.Red |= bit
+		} else {
+			return Bitboard{}, errors.New(fmt.Sprintf("invalid piece at %v", pos))
+		}
+		if piece.King {
+			bitboard.Kings |= bit
+		}
+	}
+	return bitboard, nil
+}
+
+// ParseBitboard reads a board string of an 8x8 board.
+func ParseBitboard(s string) (bitboard Bitboard, err error) {
+	if len(s) != BITBOARD_DIM*BITBOARD_DIM+BITBOARD_DIM-1 {
+		return Bitboard{}, errors.New(fmt.Sprintf("invalid board string: %v", s))
+	}
+	for y, row := range strings.Split(s, ROW_SEP) {
+		if len(row) != BITBOARD_DIM {
+			return Bitboard{}, errors.New(fmt.Sprintf("invalid board string: %v", s))
+		}
+		for x, c := range strings.Split(row, "") {
+			piece, ok := ParsePiece(c)
+			if !ok {
+				return Bitboard{}, errors.New(fmt.Sprintf("invalid board, invalid piece at %v, %v", x, y))
+			}
+			if piece == NO_PIECE {
+				continue
+			}
+			bit, ok := bitOf(Pos{x, y})
+			if !ok {
+				return Bitboard{}, errors.New(fmt.Sprintf("invalid board, piece on unusable square: %v, %v", x, y))
+			}
+			if piece.Player == BLACK_PLAYER {
+				bitboard.Black |= bit
+			} else {
+				bitboard.Red |= bit
+			}
+			if piece.King {
+				bitboard.Kings |= bit
+			}
+		}
+	}
+	return bitboard, nil
+}
+
+func (bitboard Bitboard) String() string {
+	var buf bytes.Buffer
+	for y := 0; y < BITBOARD_DIM; y++ {
+		for x := 0; x < BITBOARD_DIM; x++ {
+			bit, ok := bitOf(Pos{x, y})
+			val := PieceStrings[NO_PLAYER]
+			if ok && bitboard.Black&bit != 0 {
+				val = PieceStrings[BLACK_PLAYER]
+			} else if ok && bitboard.Red&bit != 0 {
+				val = PieceStrings[RED_PLAYER]
+			}
+			if ok && bitboard.Kings&bit != 0 {
+				val = strings.ToUpper(val)
+			}
+			buf.WriteString(val)
+		}
+		if y < BITBOARD_DIM-1 {
+			buf.WriteString(ROW_SEP)
+		}
+	}
+	return buf.String()
+}
+
+// Pieces unpacks the bitboard.
+func (bitboard Bitboard) Pieces() map[Pos]Piece {
+	pieces := make(map[Pos]Piece, bits.OnesCount32(bitboard.Black|bitboard.Red))
+	for index := 0; index < bitboardSquares; index++ {
+		bit := uint32(1) << index
+		if bitboard.Black&bit != 0 {
+			pieces[posOfBit(index)] = Piece{BLACK_PLAYER, bitboard.Kings&bit != 0}
+		} else if bitboard.Red&bit != 0 {
+			pieces[posOfBit(index)] = Piece{RED_PLAYER, bitboard.Kings&bit != 0}
+		}
+	}
+	return pieces
+}
+
+// put adds a piece on an empty square.
+func (bitboard *Bitboard) put(pos Pos, piece Piece) {
+	bit, _ := bitOf(pos)
+	if piece.Player == BLACK_PLAYER {
+		bitboard.Black |= bit
+	} else {
+		bitboard.Red |= bit
+	}
+	if piece.King {
+		bitboard.Kings |= bit
+	}
+}
+
+func (bitboard *Bitboard) remove(pos Pos) {
+	bit, _ := bitOf(pos)
+	bitboard.Black &^= bit
+	bitboard.Red &^= bit
+	bitboard.Kings &^= bit
+}
+
+func (bitboard Bitboard) own(player Player) (own uint32, opponent uint32) {
+	if player == BLACK_PLAYER {
+		return bitboard.Black, bitboard.Red
+	}
+	return bitboard.Red, bitboard.Black
+}
+
+func (bitboard Bitboard) empty() uint32 {
+	return ^(bitboard.Black | bitboard.Red)
+}
+
+// piecesGoing are the pieces of the player allowed to go in the direction:
+// all their kings, and their men when it is forward for them.
+func (bitboard Bitboard) piecesGoing(player Player, direction bitDirection) uint32 {
+	own, _ := bitboard.own(player)
+	if direction.forwardOf == player {
+		return own
+	}
+	return own & bitboard.Kings
+}
+
+func (direction bitDirection) steppers(pieces uint32, empty uint32) uint32 {
+	return pieces &^ direction.stepEdge &
+		(bitboardEven&towards(empty, direction.evenStep) | bitboardOdd&towards(empty, direction.oddStep))
+}
+
+func (direction bitDirection) jumpers(pieces uint32, opponent uint32, empty uint32) uint32 {
+	return pieces &^ direction.jumpEdge &
+		(bitboardEven&towards(opponent, direction.evenStep) | bitboardOdd&towards(opponent, direction.oddStep)) &
+		towards(empty, direction.evenStep+direction.oddStep)
+}
+
+// Movers are the pieces of the player that can step to an empty square.
+func (bitboard Bitboard) Movers(player Player) (movers uint32) {
+	for _, direction := range bitDirections {
+		movers |= direction.steppers(bitboard.piecesGoing(player, direction), bitboard.empty())
+	}
+	return movers
+}
+
+// Jumpers are the pieces of the player that can capture.
+func (bitboard Bitboard) Jumpers(player Player) (jumpers uint32) {
+	_, opponent := bitboard.own(player)
+	for _, direction := range bitDirections {
+		jumpers |= direction.jumpers(bitboard.piecesGoing(player, direction), opponent, bitboard.empty())
+	}
+	return jumpers
+}
+
+// LegalMoves lists the first hops the player can make, only captures when
+// there is one, ordered as Game.LegalMoves orders them.
+func (bitboard Bitboard) LegalMoves(player Player) []Move {
+	_, opponent := bitboard.own(player)
+	capture := bitboard.Jumpers(player) != 0
+	moves := []Move{}
+	for index := 0; index < bitboardSquares; index++ {
+		bit := uint32(1) << index
+		for _, direction := range bitDirections {
+			pieces := bitboard.piecesGoing(player, direction) & bit
+			if pieces == 0 {
+				continue
+			}
+			step := direction.oddStep
+			if bit&bitboardEven != 0 {
+				step = direction.evenStep
+			}
+			if capture && direction.jumpers(pieces, opponent, bitboard.empty()) != 0 {
+				moves = append(moves, Move{posOfBit(index), posOfBit(index + direction.evenStep + direction.oddStep)})
+			} else if !capture && direction.steppers(pieces, bitboard.empty()) != 0 {
+				moves = append(moves, Move{posOfBit(index), posOfBit(index + step)})
+			}
+		}
+	}
+	sort.Slice(moves, func(i, j int) bool {
+		if moves[i].Src != moves[j].Src {
+			return lessPos(moves[i].Src, moves[j].Src)
+		}
+		return lessPos(moves[i].Dst, moves[j].Dst)
+	})
+	return moves
+}
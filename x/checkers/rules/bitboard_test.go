@@ -0,0 +1,114 @@
+package rules
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBitboardStart(t *testing.T) {
+	board := New().String()
+	bitboard, err := ParseBitboard(board)
+	require.Nil(t, err)
+
+	require.Equal(t, Bitboard{Black: 0x00000FFF, Red: 0xFFF00000}, bitboard)
+	require.Equal(t, board, bitboard.String())
+	require.Equal(t, New().Pieces, bitboard.Pieces())
+	require.Equal(t, uint32(0x00000F00), bitboard.Movers(BLACK_PLAYER))
+	require.Equal(t, uint32(0x000F0000)<<4, bitboard.Movers(RED_PLAYER))
+	require.Zero(t, bitboard.Jumpers(BLACK_PLAYER))
+	require.Equal(t, New().LegalMoves(BLACK_PLAYER), bitboard.LegalMoves(BLACK_PLAYER))
+}
+
+func TestBitboardKingsAndEdges(t *testing.T) {
+	board := "*******B|R*******|*b******|r*******|********|********|*r*****b|b*******"
+	bitboard, err := ParseBitboard(board)
+	require.Nil(t, err)
+	game, err := Parse(board)
+	require.Nil(t, err)
+
+	require.Equal(t, board, bitboard.String())
+	for _, player := range []Player{BLACK_PLAYER, RED_PLAYER} {
+		require.Equal(t, game.LegalMoves(player), bitboard.LegalMoves(player))
+	}
+}
+
+func TestBitboardRejectsUnusableSquares(t *testing.T) {
+	_, err := ParseBitboard("b*******|********|********|********|********|********|********|********")
+	require.EqualError(t, err, "invalid board, piece on unusable square: 0, 0")
+	_, err = NewBitboard(map[Pos]Piece{{0, 0}: {BLACK_PLAYER, false}})
+	require.NotNil(t, err)
+	_, err = ParseBitboard(NewGame(International).String())
+	require.NotNil(t, err)
+}
+
+// TestBitboardAgreesWithGame compares the masks with the moves found on the
+// map of pieces along random games.
+func TestBitboardAgreesWithGame(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	positions := 0
+	for round := 0; round < 50; round++ {
+		game := New()
+		for ply := 0; ply < 150; ply++ {
+			board := game.String()
+			bitboard, err := ParseBitboard(board)
+			require.Nil(t, err)
+			require.Equal(t, board, bitboard.String())
+			require.Equal(t, game.Pieces, bitboard.Pieces())
+			for _, player := range []Player{BLACK_PLAYER, RED_PLAYER} {
+				require.Equal(t, game.LegalMoves(player), bitboard.LegalMoves(player), board)
+			}
+			positions++
+
+			sequences := game.LegalSequences(game.Turn)
+			if len(sequences) == 0 {
+				break
+			}
+			_, err = game.MoveSequence(sequences[random.Intn(len(sequences))].Path)
+			require.Nil(t, err)
+		}
+	}
+	require.Less(t, 1000, positions)
+}
+
+// TestGameKeepsBitboard checks the bitboard kept by the game against one
+// packed from scratch as the game moves, captures and promotes pieces.
+func TestGameKeepsBitboard(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	for _, ruleSet := range []*RuleSet{English, Giveaway} {
+		for round := 0; round < 10; round++ {
+			game := NewGame(ruleSet)
+			for ply := 0; ply < 150; ply++ {
+				kept, ok := game.bitboard()
+				require.True(t, ok)
+				packed, err := NewBitboard(game.Pieces)
+				require.Nil(t, err)
+				require.Equal(t, packed, kept, game.String())
+
+				sequences := game.LegalSequences(game.Turn)
+				if len(sequences) == 0 {
+					break
+				}
+				sequence := sequences[random.Intn(len(sequences))]
+				switch ply % 3 {
+				case 0:
+					_, err = game.MoveSequence(sequence.Path)
+					require.Nil(t, err)
+				case 1:
+					game.PlaySequence(sequence)
+				default:
+					for hop := 1; hop < len(sequence.Path); hop++ {
+						_, err = game.Move(sequence.Path[hop-1], sequence.Path[hop])
+						require.Nil(t, err)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestNoBitboardForInternational(t *testing.T) {
+	_, ok := NewGame(International).bitboard()
+	require.False(t, ok)
+}
@@ -8,10 +8,9 @@ import (
 )
 
 const (
-	BOARD_DIM = 8
-	RED       = "red"
-	BLACK     = "black"
-	ROW_SEP   = "|"
+	RED     = "red"
+	BLACK   = "black"
+	ROW_SEP = "|"
 )
 
 type Player struct {
@@ -27,6 +26,7 @@ var PieceStrings = map[Player]string{
 	RED_PLAYER:   "r",
 	BLACK_PLAYER: "b",
 	NO_PLAYER:    "*",
+	DRAW_PLAYER:  "d",
 }
 
 var NO_PIECE = Piece{NO_PLAYER, false}
@@ -52,6 +52,11 @@ var NO_PLAYER = Player{
 	Color: "NO_PLAYER",
 }
 
+// DRAW_PLAYER is reported as the winner of a game that ended in a draw.
+var DRAW_PLAYER = Player{
+	Color: "DRAW",
+}
+
 var Players = map[string]Player{
 	RED:   RED_PLAYER,
 	BLACK: BLACK_PLAYER,
@@ -62,78 +67,48 @@ var Opponents = map[Player]Player{
 	RED_PLAYER:   BLACK_PLAYER,
 }
 
-var Usable = map[Pos]bool{}
-var Moves = map[Player]map[Pos]map[Pos]bool{}
-var Jumps = map[Player]map[Pos]map[Pos]Pos{}
-var KingMoves = map[Pos]map[Pos]bool{}
-var KingJumps = map[Pos]map[Pos]Pos{}
-
-func Capture(src, dst Pos) Pos {
-	return Pos{(src.X + dst.X) / 2, (src.Y + dst.Y) / 2}
-}
-
-func init() {
-
-	// Initialize usable spaces
-	for y := 0; y < BOARD_DIM; y++ {
-		for x := (y + 1) % 2; x < BOARD_DIM; x += 2 {
-			Usable[Pos{X: x, Y: y}] = true
-		}
-	}
-
-	// Initialize deep maps
-	for _, p := range Players {
-		Moves[p] = map[Pos]map[Pos]bool{}
-		Jumps[p] = map[Pos]map[Pos]Pos{}
-	}
-
-	// Compute possible moves, jumps and captures
-	for pos := range Usable {
-		KingMoves[pos] = map[Pos]bool{}
-		KingJumps[pos] = map[Pos]Pos{}
-		var directions = []int{1, -1}
-		for i, player := range []Player{BLACK_PLAYER, RED_PLAYER} {
-			Moves[player][pos] = map[Pos]bool{}
-			Jumps[player][pos] = map[Pos]Pos{}
-			movOff := 1
-			jmpOff := 2
-			for _, direction := range directions {
-				mov := Pos{pos.X + (movOff * direction), pos.Y + (movOff * directions[i])}
-				if Usable[mov] {
-					Moves[player][pos][mov] = true
-					KingMoves[pos][mov] = true
-				}
-				jmp := Pos{pos.X + (jmpOff * direction), pos.Y + (jmpOff * directions[i])}
-				if Usable[jmp] {
-					capturePos := Capture(pos, jmp)
-					Jumps[player][pos][jmp] = capturePos
-					KingJumps[pos][jmp] = capturePos
-				}
-			}
-		}
-	}
-}
-
 type Game struct {
-	Pieces map[Pos]Piece
-	Turn   Player
+	Pieces  map[Pos]Piece
+	Turn    Player
+	RuleSet *RuleSet
+	// History lists the positions reached since the last capture or man move,
+	// starting with the one right after it, or with the position the game was
+	// parsed from.
+	History    []string
+	DrawLimits DrawLimits
+	// piecesHash is the Zobrist hash of the pieces once hashed is set
+	piecesHash uint64
+	hashed     bool
+	// bits holds the pieces once packed is set, for rule sets with Bitboards
+	bits   Bitboard
+	packed bool
 }
 
 func New() *Game {
-	pieces := make(map[Pos]Piece)
-	game := &Game{pieces, BLACK_PLAYER}
-	game.addInitialPieces()
-	return game
+	return NewGame(English)
 }
 
-func (game *Game) addInitialPieces() {
-	for pos := range Usable {
-		if pos.Y >= 0 && pos.Y < 3 {
-			game.Pieces[pos] = Piece{BLACK_PLAYER, false}
-		}
-		if pos.Y >= BOARD_DIM-3 && pos.Y < BOARD_DIM {
-			game.Pieces[pos] = Piece{RED_PLAYER, false}
-		}
+func NewGame(ruleSet *RuleSet) *Game {
+	return &Game{Pieces: ruleSet.StartPieces(), Turn: BLACK_PLAYER, RuleSet: ruleSet}
+}
+
+func (game *Game) Copy() *Game {
+	pieces := make(map[Pos]Piece, len(game.Pieces))
+	for pos, piece := range game.Pieces {
+		pieces[pos] = piece
+	}
+	history := make([]string, len(game.History))
+	copy(history, game.History)
+	return &Game{
+		Pieces:     pieces,
+		Turn:       game.Turn,
+		RuleSet:    game.RuleSet,
+		History:    history,
+		DrawLimits: game.DrawLimits,
+		piecesHash: game.piecesHash,
+		hashed:     game.hashed,
+		bits:       game.bits,
+		packed:     game.packed,
 	}
 }
 
@@ -146,48 +121,59 @@ func (game *Game) TurnIs(player Player) bool {
 	return game.Turn == player
 }
 
+// Winner decides, according to the end condition of the rule set, who won
+// when the player to move cannot move, either because they have no pieces
+// left or because every piece is blocked. It returns DRAW_PLAYER when one of
+// the draw limits is reached.
 func (game *Game) Winner() Player {
-	red_count := 0
-	black_count := 0
-	for _, piece := range game.Pieces {
-		switch {
-		case piece.Player == BLACK_PLAYER:
-			black_count += 1
-		case piece.Player == RED_PLAYER:
-			red_count += 1
-		}
+	if !game.playerHasMove(game.Turn) {
+		return game.RuleSet.StuckWinner(game.Turn)
 	}
-	if black_count > 0 && red_count <= 0 {
-		return BLACK_PLAYER
-	} else if red_count > 0 && black_count <= 0 {
-		return RED_PLAYER
+	if game.IsDraw() {
+		return DRAW_PLAYER
 	}
 	return NO_PLAYER
 }
 
+// ValidMove tells whether moving the piece at src to dst is a legal first
+// step of its player's turn.
 func (game *Game) ValidMove(src, dst Pos) bool {
 	if !game.PieceAt(src) || game.PieceAt(dst) {
 		return false
 	}
 	piece := game.Pieces[src]
-	if (!piece.King && Moves[piece.Player][src][dst]) || (piece.King && KingMoves[src][dst]) {
-		return !game.playerHasJump(piece.Player)
+	if game.RuleSet.MajorityCapture {
+		for _, sequence := range game.LegalSequences(piece.Player) {
+			if sequence.Path[0] == src && sequence.Path[1] == dst {
+				return true
+			}
+		}
+		return false
+	}
+	for _, step := range game.stepsFrom(src) {
+		if step.Dst == dst {
+			return !game.playerHasJump(piece.Player)
+		}
 	}
 	return game.ValidJump(src, dst)
 }
 
+// ValidJump tells whether the piece at src can capture by landing on dst.
 func (game *Game) ValidJump(src, dst Pos) bool {
+	_, found := game.jumpCapture(src, dst)
+	return found
+}
+
+func (game *Game) jumpCapture(src, dst Pos) (captured Pos, found bool) {
 	if !game.PieceAt(src) || game.PieceAt(dst) {
-		return false
+		return NO_POS, false
 	}
-	piece := game.Pieces[src]
-	if !piece.King {
-		capLoc, jumpOk := Jumps[piece.Player][src][dst]
-		return jumpOk && game.PieceAt(capLoc) && game.Pieces[capLoc].Player == Opponents[piece.Player]
-	} else {
-		capLoc, kingJumpOk := KingJumps[src][dst]
-		return kingJumpOk && game.PieceAt(capLoc) && game.Pieces[capLoc].Player == Opponents[piece.Player]
+	for _, hop := range game.captureHops(src, game.Pieces[src], nil) {
+		if hop.Dst == dst {
+			return hop.Captured, true
+		}
 	}
+	return NO_POS, false
 }
 
 func (game *Game) kingPiece(dst Pos) {
@@ -195,17 +181,17 @@ func (game *Game) kingPiece(dst Pos) {
 		return
 	}
 	piece := game.Pieces[dst]
-	if (dst.Y == 0 && piece.Player == RED_PLAYER) ||
-		(dst.Y == BOARD_DIM-1 && piece.Player == BLACK_PLAYER) {
+	if game.RuleSet.Promotes(piece.Player, dst) {
 		piece.King = true
-		game.Pieces[dst] = piece
+		game.setPiece(dst, piece)
 	}
 }
 
+// updateTurn passes the turn to the opponent, even one who cannot move and
+// therefore lost, unless the piece that just jumped can jump again.
 func (game *Game) updateTurn(dst Pos, jumped bool) {
-	opponent := Opponents[game.Turn]
-	if (!jumped || !game.jumpPossibleFrom(dst)) && game.playerHasMove(opponent) {
-		game.Turn = opponent
+	if !jumped || !game.jumpPossibleFrom(dst) {
+		game.Turn = Opponents[game.Turn]
 	}
 }
 
@@ -213,49 +199,15 @@ func (game *Game) jumpPossibleFrom(src Pos) bool {
 	if !game.PieceAt(src) {
 		return false
 	}
-	piece := game.Pieces[src]
-	if !piece.King {
-		// enumerate all player jumps and return true if one is valid
-		for dst := range Jumps[piece.Player][src] {
-			if game.ValidJump(src, dst) {
-				return true
-			}
-		}
-	} else {
-		// enumerate all king jumps and return true if one is valid
-		for dst := range KingJumps[src] {
-			if game.ValidJump(src, dst) {
-				return true
-			}
-		}
-	}
-	return false
-}
-
-func (game *Game) movePossibleFrom(src Pos) bool {
-	if !game.PieceAt(src) {
-		return false
-	}
-	piece := game.Pieces[src]
-	if !piece.King {
-		for dst := range Moves[piece.Player][src] {
-			if game.ValidMove(src, dst) {
-				return true
-			}
-		}
-	} else {
-		for dst := range KingMoves[src] {
-			if game.ValidMove(src, dst) {
-				return true
-			}
-		}
-	}
-	return false
+	return len(game.captureHops(src, game.Pieces[src], nil)) > 0
 }
 
 func (game *Game) playerHasMove(player Player) bool {
+	if bitboard, ok := game.bitboard(); ok {
+		return bitboard.Movers(player)|bitboard.Jumpers(player) != 0
+	}
 	for loc, piece := range game.Pieces {
-		if piece.Player == player && (game.movePossibleFrom(loc) || game.jumpPossibleFrom(loc)) {
+		if piece.Player == player && (len(game.stepsFrom(loc)) > 0 || game.jumpPossibleFrom(loc)) {
 			return true
 		}
 	}
@@ -263,6 +215,9 @@ func (game *Game) playerHasMove(player Player) bool {
 }
 
 func (game *Game) playerHasJump(player Player) bool {
+	if bitboard, ok := game.bitboard(); ok {
+		return bitboard.Jumpers(player) != 0
+	}
 	for loc, piece := range game.Pieces {
 		if piece.Player == player && game.jumpPossibleFrom(loc) {
 			return true
@@ -283,27 +238,43 @@ func (game *Game) Move(src, dst Pos) (captured Pos, err error) {
 	if !game.TurnIs(game.Pieces[src].Player) {
 		return NO_POS, errors.New(fmt.Sprintf("Not %v's turn", game.Pieces[src].Player))
 	}
+	if game.RuleSet.MajorityCapture {
+		// a single step has to be a whole turn
+		sequenceCaptured, err := game.playSequence([]Pos{src, dst})
+		if err != nil {
+			return NO_POS, errors.New(fmt.Sprintf("Invalid move: %v to %v", src, dst))
+		}
+		if len(sequenceCaptured) > 0 {
+			captured = sequenceCaptured[0]
+		}
+		return captured, nil
+	}
 	if !game.ValidMove(src, dst) {
 		return NO_POS, errors.New(fmt.Sprintf("Invalid move: %v to %v", src, dst))
 	}
-	if game.ValidJump(src, dst) {
-		game.Pieces[dst] = game.Pieces[src]
-		delete(game.Pieces, src)
-		captured = Capture(src, dst)
-		delete(game.Pieces, captured)
+	game.startHistory()
+	progress := !game.Pieces[src].King
+	if jumped, ok := game.jumpCapture(src, dst); ok {
+		progress = true
+		game.setPiece(dst, game.Pieces[src])
+		game.removePiece(src)
+		captured = jumped
+		game.removePiece(captured)
 	} else {
-		game.Pieces[dst] = game.Pieces[src]
-		delete(game.Pieces, src)
+		game.setPiece(dst, game.Pieces[src])
+		game.removePiece(src)
 	}
 	game.updateTurn(dst, captured != NO_POS)
 	game.kingPiece(dst)
+	game.recordPosition(progress)
 	return
 }
 
 func (game *Game) String() string {
 	var buf bytes.Buffer
-	for y := 0; y < BOARD_DIM; y++ {
-		for x := 0; x < BOARD_DIM; x++ {
+	dim := game.RuleSet.BoardDim
+	for y := 0; y < dim; y++ {
+		for x := 0; x < dim; x++ {
 			pos := Pos{x, y}
 			if game.PieceAt(pos) {
 				piece := game.Pieces[pos]
@@ -316,7 +287,7 @@ func (game *Game) String() string {
 				buf.WriteString(PieceStrings[NO_PLAYER])
 			}
 		}
-		if y < (BOARD_DIM - 1) {
+		if y < (dim - 1) {
 			buf.WriteString(ROW_SEP)
 		}
 	}
@@ -329,22 +300,73 @@ func ParsePiece(s string) (Piece, bool) {
 }
 
 func Parse(s string) (*Game, error) {
-	if len(s) != BOARD_DIM*BOARD_DIM+(BOARD_DIM-1) {
+	return ParseWithRuleSet(English, s)
+}
+
+func ParseWithRuleSet(ruleSet *RuleSet, s string) (*Game, error) {
+	dim := ruleSet.BoardDim
+	if len(s) != dim*dim+(dim-1) {
 		return nil, errors.New(fmt.Sprintf("invalid board string: %v", s))
 	}
 	pieces := make(map[Pos]Piece)
-	result := &Game{pieces, BLACK_PLAYER}
+	result := &Game{Pieces: pieces, Turn: BLACK_PLAYER, RuleSet: ruleSet}
 	for y, row := range strings.Split(s, ROW_SEP) {
 		for x, c := range strings.Split(row, "") {
-			if x >= BOARD_DIM || y >= BOARD_DIM {
+			if x >= dim || y >= dim {
 				return nil, errors.New(fmt.Sprintf("invalid board, piece out of bounds: %v, %v", x, y))
 			}
 			if piece, ok := ParsePiece(c); !ok {
 				return nil, errors.New(fmt.Sprintf("invalid board, invalid piece at %v, %v", x, y))
 			} else if piece != NO_PIECE {
+				if !ruleSet.Usable(Pos{x, y}) {
+					return nil, errors.New(fmt.Sprintf("invalid board, piece on unusable square: %v, %v", x, y))
+				}
 				result.Pieces[Pos{x, y}] = piece
 			}
 		}
 	}
 	return result, nil
 }
+
+// bitboard returns the pieces packed when the rule set allows it. They are
+// packed the first time, then kept up to date along with Pieces as the hash
+// is, so that code that changes Pieces directly must do so before.
+func (game *Game) bitboard() (bitboard Bitboard, ok bool) {
+	if !game.RuleSet.Bitboards {
+		return Bitboard{}, false
+	}
+	if !game.packed {
+		bitboard, err := NewBitboard(game.Pieces)
+		if err != nil {
+			return Bitboard{}, false
+		}
+		game.bits, game.packed = bitboard, true
+	}
+	return game.bits, true
+}
+
+// setPiece puts a piece on the board, replacing whatever stood there.
+func (game *Game) setPiece(pos Pos, piece Piece) {
+	game.removePiece(pos)
+	game.Pieces[pos] = piece
+	if game.hashed {
+		game.piecesHash ^= zobristPieceKey(pos, piece)
+	}
+	if game.packed {
+		game.bits.put(pos, piece)
+	}
+}
+
+func (game *Game) removePiece(pos Pos) {
+	piece, found := game.Pieces[pos]
+	if !found {
+		return
+	}
+	delete(game.Pieces, pos)
+	if game.hashed {
+		game.piecesHash ^= zobristPieceKey(pos, piece)
+	}
+	if game.packed {
+		game.bits.remove(pos)
+	}
+}
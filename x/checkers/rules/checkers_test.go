@@ -0,0 +1,45 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const blockedRedBoard = "********|********|********|********|***b****|********|*b******|r*******"
+
+func TestWinnerNoneAtStart(t *testing.T) {
+	require.Equal(t, NO_PLAYER, New().Winner())
+}
+
+func TestWinnerWhenNoPiecesLeft(t *testing.T) {
+	game, err := Parse("********|********|*b******|**r*****|********|********|********|********")
+	require.Nil(t, err)
+
+	_, err = game.Move(Pos{1, 2}, Pos{3, 4})
+	require.Nil(t, err)
+	require.Equal(t, RED_PLAYER, game.Turn)
+	require.Equal(t, BLACK_PLAYER, game.Winner())
+}
+
+func TestWinnerWhenBlocked(t *testing.T) {
+	game, err := Parse(blockedRedBoard)
+	require.Nil(t, err)
+	require.Equal(t, NO_PLAYER, game.Winner())
+
+	_, err = game.Move(Pos{3, 4}, Pos{2, 5})
+	require.Nil(t, err)
+	require.Equal(t, RED_PLAYER, game.Turn)
+	require.Empty(t, game.LegalMoves(RED_PLAYER))
+	require.Equal(t, BLACK_PLAYER, game.Winner())
+}
+
+func TestTurnStaysDuringJumpSequence(t *testing.T) {
+	game, err := Parse("********|********|*b******|**r*****|********|****r***|********|********")
+	require.Nil(t, err)
+
+	_, err = game.Move(Pos{1, 2}, Pos{3, 4})
+	require.Nil(t, err)
+	require.Equal(t, BLACK_PLAYER, game.Turn)
+	require.Equal(t, NO_PLAYER, game.Winner())
+}
@@ -0,0 +1,69 @@
+package rules
+
+// DrawLimits configures the automatic draws. A zero limit disables its rule.
+type DrawLimits struct {
+	// Repetition is how many times the same position must occur
+	Repetition uint64
+	// NoProgress is how many moves without a capture or a man move are allowed
+	NoProgress uint64
+}
+
+// PositionKey identifies the pieces on the board together with the side to
+// move, so that equal keys are repetitions of the same position. It is the
+// Zobrist hash in hexadecimal, short enough to be kept in the stored games.
+func (game *Game) PositionKey() string {
+	return formatHash(game.Hash())
+}
+
+// startHistory records the current position when the game was parsed
+// without any history, so that it counts towards repetitions.
+func (game *Game) startHistory() {
+	if len(game.History) == 0 {
+		game.recordPosition(true)
+	}
+}
+
+// recordPosition appends the current position to the history, which a
+// capture or a man move resets as no earlier position can occur again. No
+// history is kept when both draw rules are disabled.
+func (game *Game) recordPosition(progress bool) {
+	if game.DrawLimits == (DrawLimits{}) {
+		game.History = nil
+	} else if progress {
+		game.History = []string{game.PositionKey()}
+	} else {
+		game.History = append(game.History, game.PositionKey())
+	}
+}
+
+// NoProgressCount is the number of moves played since the last capture or
+// man move.
+func (game *Game) NoProgressCount() uint64 {
+	if len(game.History) == 0 {
+		return 0
+	}
+	return uint64(len(game.History) - 1)
+}
+
+// RepetitionCount is the number of times the current position occurred.
+func (game *Game) RepetitionCount() uint64 {
+	if len(game.History) == 0 {
+		return 0
+	}
+	current := game.History[len(game.History)-1]
+	count := uint64(0)
+	for _, position := range game.History {
+		if position == current {
+			count++
+		}
+	}
+	return count
+}
+
+// IsDraw tells whether one of the draw limits has been reached.
+func (game *Game) IsDraw() bool {
+	if 0 < game.DrawLimits.Repetition && game.DrawLimits.Repetition <= game.RepetitionCount() {
+		return true
+	}
+	return 0 < game.DrawLimits.NoProgress && game.DrawLimits.NoProgress <= game.NoProgressCount()
+}
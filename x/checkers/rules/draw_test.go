@@ -0,0 +1,70 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// two kings in opposite corners that can shuffle forever
+const shufflingKingsBoard = "********|B*******|********|********|********|********|*******R|********"
+
+func shuffleKings(t *testing.T, game *Game, rounds int) {
+	for i := 0; i < rounds; i++ {
+		for _, move := range [][2]Pos{
+			{{0, 1}, {1, 0}},
+			{{7, 6}, {6, 7}},
+			{{1, 0}, {0, 1}},
+			{{6, 7}, {7, 6}},
+		} {
+			_, err := game.Move(move[0], move[1])
+			require.Nil(t, err)
+		}
+	}
+}
+
+func TestDrawByRepetition(t *testing.T) {
+	game, err := Parse(shufflingKingsBoard)
+	require.Nil(t, err)
+	game.DrawLimits = DrawLimits{Repetition: 3}
+
+	shuffleKings(t, game, 1)
+	require.EqualValues(t, 2, game.RepetitionCount())
+	require.Equal(t, NO_PLAYER, game.Winner())
+	shuffleKings(t, game, 1)
+	require.EqualValues(t, 3, game.RepetitionCount())
+	require.Equal(t, DRAW_PLAYER, game.Winner())
+}
+
+func TestDrawByNoProgress(t *testing.T) {
+	game, err := Parse(shufflingKingsBoard)
+	require.Nil(t, err)
+	game.DrawLimits = DrawLimits{NoProgress: 10}
+
+	shuffleKings(t, game, 2)
+	require.EqualValues(t, 8, game.NoProgressCount())
+	require.Equal(t, NO_PLAYER, game.Winner())
+	shuffleKings(t, game, 1)
+	require.EqualValues(t, 12, game.NoProgressCount())
+	require.Equal(t, DRAW_PLAYER, game.Winner())
+}
+
+func TestDrawLimitsDisabled(t *testing.T) {
+	game, err := Parse(shufflingKingsBoard)
+	require.Nil(t, err)
+
+	shuffleKings(t, game, 5)
+	require.Equal(t, NO_PLAYER, game.Winner())
+	require.Empty(t, game.History)
+}
+
+func TestManMoveResetsHistory(t *testing.T) {
+	game := New()
+	game.DrawLimits = DrawLimits{NoProgress: 1}
+
+	_, err := game.Move(Pos{1, 2}, Pos{2, 3})
+	require.Nil(t, err)
+	require.Equal(t, []string{game.PositionKey()}, game.History)
+	require.EqualValues(t, 0, game.NoProgressCount())
+	require.Equal(t, NO_PLAYER, game.Winner())
+}
@@ -0,0 +1,22 @@
+package rules
+
+const ENGLISH = "english"
+
+// English draughts, also known as American checkers: men move and capture
+// forwards only, kings move a single square, and any capture may be chosen.
+var English = &RuleSet{
+	Name:                 ENGLISH,
+	BoardDim:             8,
+	StartRows:            3,
+	ManSteps:             forwardDiagonals,
+	ManJumps:             forwardDiagonals,
+	KingDirections:       allDiagonals,
+	PromotionEndsCapture: true,
+	Bitboards:            true,
+	EndCondition:         STUCK_PLAYER_LOSES,
+	PdnGameType:          21,
+}
+
+func init() {
+	Register(English)
+}
@@ -0,0 +1,146 @@
+package rules
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// FEN describes a position with the side to move followed by the numbered
+// squares of each side's pieces, kings being prefixed with K, such as
+// W:W18,K23:B1,2,3. The red player is called white, as in PDN, unless the
+// rule set has NotationWhiteFirst.
+const (
+	FEN_WHITE    = "W"
+	FEN_BLACK    = "B"
+	FEN_KING     = "K"
+	FEN_SEP      = ":"
+	FEN_LIST_SEP = ","
+	FEN_RANGE    = "-"
+)
+
+var fenColors = map[string]Player{
+	FEN_WHITE: RED_PLAYER,
+	FEN_BLACK: BLACK_PLAYER,
+}
+
+// IsFen tells whether a position is written as a FEN rather than as a board
+// string.
+func IsFen(s string) bool {
+	return strings.Contains(s, FEN_SEP)
+}
+
+// ParsePosition reads a position written either as a FEN or as a board
+// string. Only a FEN sets whose turn it is, black playing first otherwise.
+func ParsePosition(ruleSet *RuleSet, s string) (*Game, error) {
+	if IsFen(s) {
+		return ParseFen(ruleSet, s)
+	}
+	return ParseWithRuleSet(ruleSet, s)
+}
+
+// ParseFen reads a FEN, in which squares can also be given as ranges such as
+// 1-12.
+func ParseFen(ruleSet *RuleSet, fen string) (*Game, error) {
+	fields := strings.Split(strings.TrimSuffix(strings.TrimSpace(fen), "."), FEN_SEP)
+	if len(fields) != 3 {
+		return nil, errors.New(fmt.Sprintf("invalid FEN, expected 3 fields: %s", fen))
+	}
+	turn, found := ruleSet.fenPlayer(fields[0])
+	if !found {
+		return nil, errors.New(fmt.Sprintf("invalid FEN, invalid side to move: %s", fields[0]))
+	}
+	game := &Game{Pieces: map[Pos]Piece{}, Turn: turn, RuleSet: ruleSet}
+	colorsSeen := map[Player]bool{}
+	for _, field := range fields[1:] {
+		if field == "" {
+			return nil, errors.New(fmt.Sprintf("invalid FEN, empty field: %s", fen))
+		}
+		player, found := ruleSet.fenPlayer(field[:1])
+		if !found || colorsSeen[player] {
+			return nil, errors.New(fmt.Sprintf("invalid FEN, invalid color: %s", field))
+		}
+		colorsSeen[player] = true
+		if len(field) == 1 {
+			continue
+		}
+		for _, item := range strings.Split(field[1:], FEN_LIST_SEP) {
+			if err := game.addFenPieces(player, item); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return game, nil
+}
+
+func (game *Game) addFenPieces(player Player, item string) error {
+	king := strings.HasPrefix(item, FEN_KING)
+	item = strings.TrimPrefix(item, FEN_KING)
+	bounds := strings.Split(item, FEN_RANGE)
+	if 2 < len(bounds) {
+		return errors.New(fmt.Sprintf("invalid FEN, invalid squares: %s", item))
+	}
+	first, errFirst := strconv.Atoi(bounds[0])
+	last, errLast := strconv.Atoi(bounds[len(bounds)-1])
+	if errFirst != nil || errLast != nil || last < first {
+		return errors.New(fmt.Sprintf("invalid FEN, invalid squares: %s", item))
+	}
+	for square := first; square <= last; square++ {
+		pos, found := game.RuleSet.SquarePos(square)
+		if !found {
+			return errors.New(fmt.Sprintf("invalid FEN, invalid square: %d", square))
+		}
+		if game.PieceAt(pos) {
+			return errors.New(fmt.Sprintf("invalid FEN, square taken twice: %d", square))
+		}
+		game.Pieces[pos] = Piece{player, king}
+	}
+	return nil
+}
+
+// Fen writes the position with its pieces in square order.
+func (game *Game) Fen() string {
+	lists := map[Player][]int{}
+	for pos, piece := range game.Pieces {
+		square, _ := game.RuleSet.Square(pos)
+		lists[piece.Player] = append(lists[piece.Player], square)
+	}
+	var buf strings.Builder
+	buf.WriteString(game.RuleSet.fenColor(game.Turn))
+	for _, color := range []string{FEN_WHITE, FEN_BLACK} {
+		player, _ := game.RuleSet.fenPlayer(color)
+		squares := lists[player]
+		sort.Ints(squares)
+		items := make([]string, 0, len(squares))
+		for _, square := range squares {
+			pos, _ := game.RuleSet.SquarePos(square)
+			item := strconv.Itoa(square)
+			if game.Pieces[pos].King {
+				item = FEN_KING + item
+			}
+			items = append(items, item)
+		}
+		buf.WriteString(FEN_SEP + color + strings.Join(items, FEN_LIST_SEP))
+	}
+	return buf.String()
+}
+
+func (ruleSet *RuleSet) fenPlayer(color string) (player Player, found bool) {
+	player, found = fenColors[color]
+	if found && ruleSet.NotationWhiteFirst {
+		player = Opponents[player]
+	}
+	return player, found
+}
+
+func (ruleSet *RuleSet) fenColor(player Player) string {
+	if ruleSet.NotationWhiteFirst {
+		player = Opponents[player]
+	}
+	if player == RED_PLAYER {
+		return FEN_WHITE
+	}
+	return FEN_BLACK
+}
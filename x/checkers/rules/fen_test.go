@@ -0,0 +1,76 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const startFen = "B:W21,22,23,24,25,26,27,28,29,30,31,32:B1,2,3,4,5,6,7,8,9,10,11,12"
+
+func TestFenOfStart(t *testing.T) {
+	game := New()
+
+	require.Equal(t, startFen, game.Fen())
+	parsed, err := ParseFen(English, startFen)
+	require.Nil(t, err)
+	require.Equal(t, game.Pieces, parsed.Pieces)
+	require.Equal(t, BLACK_PLAYER, parsed.Turn)
+}
+
+func TestParseFenKingsRangesAndTurn(t *testing.T) {
+	game, err := ParseFen(English, "W:WK18,21-22:B1-3,K32.")
+	require.Nil(t, err)
+
+	require.Equal(t, RED_PLAYER, game.Turn)
+	require.Equal(t, map[Pos]Piece{
+		{3, 4}: {RED_PLAYER, true},
+		{0, 5}: {RED_PLAYER, false},
+		{2, 5}: {RED_PLAYER, false},
+		{1, 0}: {BLACK_PLAYER, false},
+		{3, 0}: {BLACK_PLAYER, false},
+		{5, 0}: {BLACK_PLAYER, false},
+		{6, 7}: {BLACK_PLAYER, true},
+	}, game.Pieces)
+	require.Equal(t, "W:WK18,21,22:B1,2,3,K32", game.Fen())
+}
+
+func TestParseFenEmptySide(t *testing.T) {
+	game, err := ParseFen(English, "W:W:B1")
+	require.Nil(t, err)
+
+	require.Len(t, game.Pieces, 1)
+	require.Equal(t, BLACK_PLAYER, game.Winner())
+	require.Equal(t, "W:W:B1", game.Fen())
+}
+
+func TestParseFenErrors(t *testing.T) {
+	for fen, message := range map[string]string{
+		"W:W21":        "invalid FEN, expected 3 fields: W:W21",
+		"R:W21:B1":     "invalid FEN, invalid side to move: R",
+		"W:W21:W1":     "invalid FEN, invalid color: W1",
+		"W:W21,33:B1":  "invalid FEN, invalid square: 33",
+		"W:W21:B1,21":  "invalid FEN, square taken twice: 21",
+		"W:W21:B4-1":   "invalid FEN, invalid squares: 4-1",
+		"W:Wabc:B1":    "invalid FEN, invalid squares: abc",
+		"W::B1":        "invalid FEN, empty field: W::B1",
+		"W:W21:B1-2-3": "invalid FEN, invalid squares: 1-2-3",
+	} {
+		_, err := ParseFen(English, fen)
+		require.EqualError(t, err, message, fen)
+	}
+}
+
+func TestParsePositionEitherFormat(t *testing.T) {
+	fromFen, err := ParsePosition(International, "W:W31-50:B1-20")
+	require.Nil(t, err)
+	require.Equal(t, NewGame(International).Pieces, fromFen.Pieces)
+	// white is the black player, who moves first
+	require.Equal(t, BLACK_PLAYER, fromFen.Turn)
+	require.Equal(t, "W:W31,32,33,34,35,36,37,38,39,40,41,42,43,44,45,46,47,48,49,50:B1,2,3,4,5,6,7,8,9,10,11,12,13,14,15,16,17,18,19,20", fromFen.Fen())
+
+	fromBoard, err := ParsePosition(International, NewGame(International).String())
+	require.Nil(t, err)
+	require.Equal(t, fromFen.Pieces, fromBoard.Pieces)
+	require.Equal(t, BLACK_PLAYER, fromBoard.Turn)
+}
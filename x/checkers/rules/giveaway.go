@@ -0,0 +1,21 @@
+package rules
+
+const GIVEAWAY = "giveaway"
+
+// Giveaway, or antidraughts, is played like English draughts, captures still
+// being compulsory, but the player who runs out of pieces or moves wins.
+var Giveaway = &RuleSet{
+	Name:                 GIVEAWAY,
+	BoardDim:             8,
+	StartRows:            3,
+	ManSteps:             forwardDiagonals,
+	ManJumps:             forwardDiagonals,
+	KingDirections:       allDiagonals,
+	PromotionEndsCapture: true,
+	Bitboards:            true,
+	EndCondition:         STUCK_PLAYER_WINS,
+}
+
+func init() {
+	Register(Giveaway)
+}
@@ -0,0 +1,34 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGiveawayLosingAllPiecesWins(t *testing.T) {
+	game, err := ParseWithRuleSet(Giveaway, "********|********|*b******|**r*****|********|********|********|********")
+	require.Nil(t, err)
+
+	require.Equal(t, NO_PLAYER, game.Winner())
+	_, err = game.Move(Pos{1, 2}, Pos{3, 4})
+	require.Nil(t, err)
+	require.Equal(t, RED_PLAYER, game.Winner())
+}
+
+func TestGiveawayBlockedPlayerWins(t *testing.T) {
+	game, err := ParseWithRuleSet(Giveaway, "*r*r****|r*r*****|*B******|********|********|********|********|********")
+	require.Nil(t, err)
+	game.Turn = RED_PLAYER
+
+	require.Equal(t, RED_PLAYER, game.Winner())
+}
+
+func TestGiveawayCaptureStillCompulsory(t *testing.T) {
+	game, err := ParseWithRuleSet(Giveaway, "********|********|*b*****b|**r*****|********|********|********|********")
+	require.Nil(t, err)
+
+	require.Equal(t, []Move{{Pos{1, 2}, Pos{3, 4}}}, game.LegalMoves(BLACK_PLAYER))
+	_, err = game.Move(Pos{7, 2}, Pos{6, 3})
+	require.NotNil(t, err)
+}
@@ -0,0 +1,27 @@
+package rules
+
+const INTERNATIONAL = "international"
+
+// International draughts on a 10x10 board: men capture backwards too, kings
+// fly, and the capture taking the most pieces is compulsory.
+var International = &RuleSet{
+	Name:      INTERNATIONAL,
+	BoardDim:  10,
+	StartRows: 4,
+	ManSteps:  forwardDiagonals,
+	ManJumps: map[Player][]Pos{
+		BLACK_PLAYER: allDiagonals,
+		RED_PLAYER:   allDiagonals,
+	},
+	KingDirections:     allDiagonals,
+	FlyingKings:        true,
+	MajorityCapture:    true,
+	DeferRemoval:       true,
+	EndCondition:       STUCK_PLAYER_LOSES,
+	PdnGameType:        20,
+	NotationWhiteFirst: true,
+}
+
+func init() {
+	Register(International)
+}
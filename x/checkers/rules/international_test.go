@@ -0,0 +1,129 @@
+package rules
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newInternationalGame(pieces map[Pos]Piece) *Game {
+	return &Game{Pieces: pieces, Turn: BLACK_PLAYER, RuleSet: International}
+}
+
+func TestInternationalStart(t *testing.T) {
+	game := NewGame(International)
+
+	require.Len(t, game.Pieces, 40)
+	require.Len(t, game.LegalMoves(BLACK_PLAYER), 9)
+	require.Equal(t, NO_PLAYER, game.Winner())
+
+	board := game.String()
+	require.Len(t, strings.Split(board, ROW_SEP), 10)
+	parsed, err := ParseWithRuleSet(International, board)
+	require.Nil(t, err)
+	require.Equal(t, game.Pieces, parsed.Pieces)
+	_, err = Parse(board)
+	require.NotNil(t, err)
+}
+
+func TestInternationalManCapturesBackwards(t *testing.T) {
+	game := newInternationalGame(map[Pos]Piece{
+		{4, 5}: {BLACK_PLAYER, false},
+		{3, 4}: {RED_PLAYER, false},
+		{9, 8}: {RED_PLAYER, false},
+	})
+
+	require.Equal(t, []Move{{Pos{4, 5}, Pos{2, 3}}}, game.LegalMoves(BLACK_PLAYER))
+	captured, err := game.Move(Pos{4, 5}, Pos{2, 3})
+	require.Nil(t, err)
+	require.Equal(t, Pos{3, 4}, captured)
+	require.Equal(t, RED_PLAYER, game.Turn)
+}
+
+func TestInternationalFlyingKingMoves(t *testing.T) {
+	game := newInternationalGame(map[Pos]Piece{
+		{9, 0}: {BLACK_PLAYER, true},
+		{0, 9}: {RED_PLAYER, false},
+	})
+
+	// the long diagonal up to the red man
+	require.Len(t, game.LegalMoves(BLACK_PLAYER), 8)
+	_, err := game.Move(Pos{9, 0}, Pos{2, 7})
+	require.Nil(t, err)
+}
+
+func TestInternationalFlyingKingCaptures(t *testing.T) {
+	game := newInternationalGame(map[Pos]Piece{
+		{9, 0}: {BLACK_PLAYER, true},
+		{6, 3}: {RED_PLAYER, false},
+		{9, 8}: {RED_PLAYER, false},
+	})
+
+	sequences := game.LegalSequences(BLACK_PLAYER)
+	require.Len(t, sequences, 6)
+	for _, sequence := range sequences {
+		require.Equal(t, []Pos{{6, 3}}, sequence.Captured)
+	}
+	captured, err := game.Move(Pos{9, 0}, Pos{1, 8})
+	require.Nil(t, err)
+	require.Equal(t, Pos{6, 3}, captured)
+}
+
+func TestInternationalMajorityCapture(t *testing.T) {
+	game := newInternationalGame(map[Pos]Piece{
+		{2, 3}: {BLACK_PLAYER, false},
+		{3, 4}: {RED_PLAYER, false},
+		{5, 6}: {RED_PLAYER, false},
+		{1, 4}: {RED_PLAYER, false},
+	})
+
+	require.Equal(t, []Sequence{{
+		Path:     []Pos{{2, 3}, {4, 5}, {6, 7}},
+		Captured: []Pos{{3, 4}, {5, 6}},
+	}}, game.LegalSequences(BLACK_PLAYER))
+	require.Equal(t, []Move{{Pos{2, 3}, Pos{4, 5}}}, game.LegalMoves(BLACK_PLAYER))
+
+	// the lesser capture and the unfinished one are refused
+	_, err := game.Move(Pos{2, 3}, Pos{0, 5})
+	require.NotNil(t, err)
+	_, err = game.Move(Pos{2, 3}, Pos{4, 5})
+	require.NotNil(t, err)
+	require.Len(t, game.Pieces, 4)
+
+	captured, err := game.MoveSequence([]Pos{{2, 3}, {4, 5}, {6, 7}})
+	require.Nil(t, err)
+	require.Equal(t, []Pos{{3, 4}, {5, 6}}, captured)
+	require.Len(t, game.Pieces, 2)
+	require.Equal(t, RED_PLAYER, game.Turn)
+}
+
+func TestInternationalCapturedPieceJumpedOnce(t *testing.T) {
+	// once past the man, the king could otherwise jump it again on the way back
+	game := newInternationalGame(map[Pos]Piece{
+		{1, 0}: {BLACK_PLAYER, true},
+		{3, 2}: {RED_PLAYER, false},
+		{9, 8}: {RED_PLAYER, false},
+	})
+
+	sequences := game.LegalSequences(BLACK_PLAYER)
+	require.Len(t, sequences, 5)
+	for _, sequence := range sequences {
+		require.Equal(t, []Pos{{3, 2}}, sequence.Captured)
+	}
+}
+
+func TestInternationalPromotionOnlyAtTheEnd(t *testing.T) {
+	game := newInternationalGame(map[Pos]Piece{
+		{2, 7}: {BLACK_PLAYER, false},
+		{3, 8}: {RED_PLAYER, false},
+		{5, 8}: {RED_PLAYER, false},
+		{0, 1}: {RED_PLAYER, false},
+	})
+
+	// passing through the last row does not make a king
+	captured, err := game.MoveSequence([]Pos{{2, 7}, {4, 9}, {6, 7}})
+	require.Nil(t, err)
+	require.Equal(t, []Pos{{3, 8}, {5, 8}}, captured)
+	require.False(t, game.Pieces[Pos{6, 7}].King)
+}
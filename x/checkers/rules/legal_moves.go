@@ -0,0 +1,103 @@
+package rules
+
+type Move struct {
+	Src Pos
+	Dst Pos
+}
+
+// hop is a single capture, landing on Dst.
+type hop struct {
+	Dst      Pos
+	Captured Pos
+}
+
+// LegalMoves returns every first step the player could make on this board,
+// in row-major order of source then destination. When the player can
+// capture, only the captures the variant allows are returned.
+func (game *Game) LegalMoves(player Player) []Move {
+	moves := []Move{}
+	for _, sequence := range game.LegalSequences(player) {
+		move := Move{sequence.Path[0], sequence.Path[1]}
+		if len(moves) == 0 || moves[len(moves)-1] != move {
+			moves = append(moves, move)
+		}
+	}
+	return moves
+}
+
+// LegalMovesFrom returns the legal moves of the piece at src. It is empty
+// when there is no piece or when another piece of the same player has a
+// capture to make.
+func (game *Game) LegalMovesFrom(src Pos) []Move {
+	if !game.PieceAt(src) {
+		return []Move{}
+	}
+	moves := []Move{}
+	for _, move := range game.LegalMoves(game.Pieces[src].Player) {
+		if move.Src == src {
+			moves = append(moves, move)
+		}
+	}
+	return moves
+}
+
+// stepsFrom lists the non capturing moves of the piece at src, regardless of
+// whether a capture is compulsory.
+func (game *Game) stepsFrom(src Pos) []Move {
+	steps := []Move{}
+	piece := game.Pieces[src]
+	directions := game.RuleSet.ManSteps[piece.Player]
+	flying := false
+	if piece.King {
+		directions = game.RuleSet.KingDirections
+		flying = game.RuleSet.FlyingKings
+	}
+	for _, direction := range directions {
+		for dst := src.plus(direction); game.RuleSet.Usable(dst) && !game.PieceAt(dst); dst = dst.plus(direction) {
+			steps = append(steps, Move{src, dst})
+			if !flying {
+				break
+			}
+		}
+	}
+	return steps
+}
+
+// captureHops lists the single captures that piece could make from src.
+// Pieces in taken were already captured in the current sequence, they cannot
+// be jumped again.
+func (game *Game) captureHops(src Pos, piece Piece, taken map[Pos]bool) []hop {
+	hops := []hop{}
+	directions := game.RuleSet.ManJumps[piece.Player]
+	flying := false
+	if piece.King {
+		directions = game.RuleSet.KingDirections
+		flying = game.RuleSet.FlyingKings
+	}
+	for _, direction := range directions {
+		over := src.plus(direction)
+		if flying {
+			for game.RuleSet.Usable(over) && !game.PieceAt(over) {
+				over = over.plus(direction)
+			}
+		}
+		target, ok := game.Pieces[over]
+		if !ok || target.Player != Opponents[piece.Player] || taken[over] {
+			continue
+		}
+		for dst := over.plus(direction); game.RuleSet.Usable(dst) && !game.PieceAt(dst); dst = dst.plus(direction) {
+			hops = append(hops, hop{dst, over})
+			if !flying {
+				break
+			}
+		}
+	}
+	return hops
+}
+
+func lessPos(a, b Pos) bool {
+	if a.Y != b.Y {
+		return a.Y < b.Y
+	}
+	return a.X < b.X
+}
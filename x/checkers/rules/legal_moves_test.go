@@ -0,0 +1,61 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLegalMovesOpening(t *testing.T) {
+	game := New()
+
+	moves := game.LegalMoves(BLACK_PLAYER)
+	require.Equal(t, []Move{
+		{Pos{1, 2}, Pos{0, 3}},
+		{Pos{1, 2}, Pos{2, 3}},
+		{Pos{3, 2}, Pos{2, 3}},
+		{Pos{3, 2}, Pos{4, 3}},
+		{Pos{5, 2}, Pos{4, 3}},
+		{Pos{5, 2}, Pos{6, 3}},
+		{Pos{7, 2}, Pos{6, 3}},
+	}, moves)
+	require.Len(t, game.LegalMoves(RED_PLAYER), 7)
+}
+
+func TestLegalMovesForcedCapture(t *testing.T) {
+	game, err := Parse("********|********|*b***b**|**r*****|********|********|********|********")
+	require.Nil(t, err)
+
+	require.Equal(t, []Move{{Pos{1, 2}, Pos{3, 4}}}, game.LegalMoves(BLACK_PLAYER))
+	require.Equal(t, []Move{{Pos{1, 2}, Pos{3, 4}}}, game.LegalMovesFrom(Pos{1, 2}))
+	require.Empty(t, game.LegalMovesFrom(Pos{5, 2}))
+}
+
+func TestLegalMovesKing(t *testing.T) {
+	game, err := Parse("********|********|********|****R***|********|********|********|********")
+	require.Nil(t, err)
+
+	require.Equal(t, []Move{
+		{Pos{4, 3}, Pos{3, 2}},
+		{Pos{4, 3}, Pos{5, 2}},
+		{Pos{4, 3}, Pos{3, 4}},
+		{Pos{4, 3}, Pos{5, 4}},
+	}, game.LegalMoves(RED_PLAYER))
+	require.Empty(t, game.LegalMoves(BLACK_PLAYER))
+	require.Empty(t, game.LegalMovesFrom(Pos{0, 0}))
+}
+
+func TestLegalMovesAgreeWithValidMove(t *testing.T) {
+	game := New()
+	for i := 0; i < 20; i++ {
+		moves := game.LegalMoves(game.Turn)
+		if len(moves) == 0 {
+			break
+		}
+		for _, move := range moves {
+			require.True(t, game.ValidMove(move.Src, move.Dst), "%v", move)
+		}
+		_, err := game.Move(moves[0].Src, moves[0].Dst)
+		require.Nil(t, err)
+	}
+}
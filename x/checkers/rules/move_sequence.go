@@ -0,0 +1,225 @@
+package rules
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// Sequence is a whole turn: the path of the moving piece and the pieces it
+// captures on the way.
+type Sequence struct {
+	Path     []Pos
+	Captured []Pos
+}
+
+// LegalSequences returns every turn the player could play, ordered by path.
+// Capture sequences go as far as they can and, when the variant requires it,
+// only those taking the most pieces are kept.
+func (game *Game) LegalSequences(player Player) []Sequence {
+	// the capture search moves pieces around in the map, so it must not be
+	// iterated at the same time
+	sources := []Pos{}
+	for src, piece := range game.Pieces {
+		if piece.Player == player {
+			sources = append(sources, src)
+		}
+	}
+	captures := []Sequence{}
+	steps := []Sequence{}
+	for _, src := range sources {
+		captures = append(captures, game.captureSequencesFrom(src)...)
+		for _, step := range game.stepsFrom(src) {
+			steps = append(steps, Sequence{Path: []Pos{step.Src, step.Dst}})
+		}
+	}
+	if len(captures) == 0 {
+		return sortSequences(steps)
+	}
+	if game.RuleSet.MajorityCapture {
+		most := 0
+		for _, capture := range captures {
+			if most < len(capture.Captured) {
+				most = len(capture.Captured)
+			}
+		}
+		majority := []Sequence{}
+		for _, capture := range captures {
+			if len(capture.Captured) == most {
+				majority = append(majority, capture)
+			}
+		}
+		captures = majority
+	}
+	return sortSequences(captures)
+}
+
+// captureSequencesFrom lists the capture sequences of the piece at src that
+// cannot be extended any further.
+func (game *Game) captureSequencesFrom(src Pos) []Sequence {
+	piece := game.Pieces[src]
+	// the moving piece leaves its square for the whole sequence
+	delete(game.Pieces, src)
+	defer func() { game.Pieces[src] = piece }()
+	sequences := []Sequence{}
+	game.extendCaptures(piece, []Pos{src}, []Pos{}, map[Pos]bool{}, &sequences)
+	return sequences
+}
+
+func (game *Game) extendCaptures(piece Piece, path []Pos, captured []Pos, taken map[Pos]bool, sequences *[]Sequence) {
+	at := path[len(path)-1]
+	hops := game.captureHops(at, piece, taken)
+	if 1 < len(path) {
+		hops = withoutReversal(hops, at, path[len(path)-2].directionTo(at))
+	}
+	if len(hops) == 0 {
+		if len(captured) > 0 {
+			*sequences = append(*sequences, Sequence{Path: path, Captured: captured})
+		}
+		return
+	}
+	for _, hop := range hops {
+		// copy so that sibling branches do not share the backing arrays
+		nextPath := append(append(make([]Pos, 0, len(path)+1), path...), hop.Dst)
+		nextCaptured := append(append(make([]Pos, 0, len(captured)+1), captured...), hop.Captured)
+		if game.RuleSet.DeferRemoval {
+			taken[hop.Captured] = true
+			game.extendCaptures(piece, nextPath, nextCaptured, taken, sequences)
+			delete(taken, hop.Captured)
+			continue
+		}
+		removed := game.Pieces[hop.Captured]
+		delete(game.Pieces, hop.Captured)
+		if !piece.King && game.RuleSet.PromotionEndsCapture && game.RuleSet.Promotes(piece.Player, hop.Dst) {
+			// becoming a king ends the turn
+			*sequences = append(*sequences, Sequence{Path: nextPath, Captured: nextCaptured})
+		} else {
+			game.extendCaptures(piece, nextPath, nextCaptured, taken, sequences)
+		}
+		game.Pieces[hop.Captured] = removed
+	}
+}
+
+// MoveSequence plays the piece at path[0] through every following position
+// within a single turn. A path of two positions is an ordinary move, longer
+// paths must be chained captures by the same piece. The game is only changed
+// when the whole path is legal.
+func (game *Game) MoveSequence(path []Pos) (captured []Pos, err error) {
+	if len(path) < 2 {
+		return nil, errors.New(fmt.Sprintf("Path too short: %v", path))
+	}
+	if game.RuleSet.MajorityCapture {
+		return game.playSequence(path)
+	}
+	if len(path) == 2 {
+		capturedPos, err := game.Move(path[0], path[1])
+		if err != nil {
+			return nil, err
+		}
+		captured = []Pos{}
+		if capturedPos != NO_POS {
+			captured = append(captured, capturedPos)
+		}
+		return captured, nil
+	}
+	played := game.Copy()
+	captured = make([]Pos, 0, len(path)-1)
+	for hop := 1; hop < len(path); hop++ {
+		src, dst := path[hop-1], path[hop]
+		if 1 < hop && !played.TurnIs(game.Turn) {
+			return nil, errors.New(fmt.Sprintf("Turn ended before hop %d: %v to %v", hop, src, dst))
+		}
+		if !played.ValidJump(src, dst) {
+			return nil, errors.New(fmt.Sprintf("Invalid jump at hop %d: %v to %v", hop, src, dst))
+		}
+		capturedPos, err := played.Move(src, dst)
+		if err != nil {
+			return nil, err
+		}
+		captured = append(captured, capturedPos)
+	}
+	game.Pieces = played.Pieces
+	game.Turn = played.Turn
+	game.History = played.History
+	game.piecesHash = played.piecesHash
+	game.hashed = played.hashed
+	game.bits = played.bits
+	game.packed = played.packed
+	return captured, nil
+}
+
+// playSequence plays the legal sequence of the player to move that follows
+// path exactly.
+func (game *Game) playSequence(path []Pos) (captured []Pos, err error) {
+	if piece, found := game.Pieces[path[0]]; found && !game.TurnIs(piece.Player) {
+		return nil, errors.New(fmt.Sprintf("Not %v's turn", piece.Player))
+	}
+	for _, sequence := range game.LegalSequences(game.Turn) {
+		if samePath(sequence.Path, path) {
+			game.applySequence(sequence)
+			return sequence.Captured, nil
+		}
+	}
+	return nil, errors.New(fmt.Sprintf("Invalid move sequence: %v", path))
+}
+
+// PlaySequence plays a sequence as returned by LegalSequences, without
+// checking it again.
+func (game *Game) PlaySequence(sequence Sequence) {
+	game.applySequence(sequence)
+}
+
+// applySequence plays a whole turn without checking it.
+func (game *Game) applySequence(sequence Sequence) {
+	game.startHistory()
+	src, dst := sequence.Path[0], sequence.Path[len(sequence.Path)-1]
+	piece := game.Pieces[src]
+	progress := !piece.King || len(sequence.Captured) > 0
+	game.removePiece(src)
+	for _, captured := range sequence.Captured {
+		game.removePiece(captured)
+	}
+	if game.RuleSet.Promotes(piece.Player, dst) {
+		piece.King = true
+	}
+	game.setPiece(dst, piece)
+	game.Turn = Opponents[game.Turn]
+	game.recordPosition(progress)
+}
+
+// withoutReversal drops the hops that would turn back along the line of the
+// previous hop, which no variant allows within a capture sequence.
+func withoutReversal(hops []hop, at Pos, previous Pos) []hop {
+	kept := make([]hop, 0, len(hops))
+	for _, hop := range hops {
+		if at.directionTo(hop.Dst) != (Pos{-previous.X, -previous.Y}) {
+			kept = append(kept, hop)
+		}
+	}
+	return kept
+}
+
+func samePath(a, b []Pos) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func sortSequences(sequences []Sequence) []Sequence {
+	sort.Slice(sequences, func(i, j int) bool {
+		a, b := sequences[i].Path, sequences[j].Path
+		for k := 0; k < len(a) && k < len(b); k++ {
+			if a[k] != b[k] {
+				return lessPos(a[k], b[k])
+			}
+		}
+		return len(a) < len(b)
+	})
+	return sequences
+}
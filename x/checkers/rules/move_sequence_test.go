@@ -0,0 +1,55 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const doubleJumpBoard = "********|********|*b******|**r*****|********|****r***|********|********"
+
+func TestMoveSequenceSingleMove(t *testing.T) {
+	game := New()
+
+	captured, err := game.MoveSequence([]Pos{{1, 2}, {2, 3}})
+	require.Nil(t, err)
+	require.Empty(t, captured)
+	require.Equal(t, RED_PLAYER, game.Turn)
+}
+
+func TestMoveSequenceDoubleJump(t *testing.T) {
+	game, err := Parse(doubleJumpBoard)
+	require.Nil(t, err)
+
+	captured, err := game.MoveSequence([]Pos{{1, 2}, {3, 4}, {5, 6}})
+	require.Nil(t, err)
+	require.Equal(t, []Pos{{2, 3}, {4, 5}}, captured)
+	require.Equal(t, "********|********|********|********|********|********|*****b**|********", game.String())
+	require.Equal(t, BLACK_PLAYER, game.Winner())
+}
+
+func TestMoveSequenceRejectsStepAfterJump(t *testing.T) {
+	game, err := Parse(doubleJumpBoard)
+	require.Nil(t, err)
+
+	_, err = game.MoveSequence([]Pos{{1, 2}, {3, 4}, {2, 5}})
+	require.EqualError(t, err, "Invalid jump at hop 2: {3 4} to {2 5}")
+	require.Equal(t, doubleJumpBoard, game.String())
+	require.Equal(t, BLACK_PLAYER, game.Turn)
+}
+
+func TestMoveSequenceRejectsOtherPiece(t *testing.T) {
+	game, err := Parse("********|********|*b***b**|**r*****|********|********|********|********")
+	require.Nil(t, err)
+
+	_, err = game.MoveSequence([]Pos{{1, 2}, {3, 4}, {5, 2}})
+	require.NotNil(t, err)
+	require.Equal(t, "********|********|*b***b**|**r*****|********|********|********|********", game.String())
+}
+
+func TestMoveSequenceRejectsShortPath(t *testing.T) {
+	game := New()
+
+	_, err := game.MoveSequence([]Pos{{1, 2}})
+	require.EqualError(t, err, "Path too short: [{1 2}]")
+}
@@ -0,0 +1,105 @@
+package rules
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Square notation numbers the usable squares from 1, in reading order from
+// the side of the black player, who moves first: 1 to 32 on an English board.
+// With NotationWhiteFirst, they are numbered from the side of the red player
+// instead.
+// A turn is written as the squares visited by the moving piece, separated by
+// - for a move, as in 11-15, or by x for captures, as in 15x24x31.
+const (
+	NOTATION_MOVE_SEP    = "-"
+	NOTATION_CAPTURE_SEP = "x"
+)
+
+var notationRegexp = regexp.MustCompile(`^\d+(-\d+|(x\d+)+)$`)
+
+// PdnMove is a whole turn: the path of the moving piece and whether it
+// captured on the way.
+type PdnMove struct {
+	Path    []Pos
+	Capture bool
+}
+
+// Square returns the number of a usable square.
+func (ruleSet *RuleSet) Square(pos Pos) (square int, found bool) {
+	if !ruleSet.Usable(pos) {
+		return 0, false
+	}
+	pos = ruleSet.notationPos(pos)
+	if ruleSet.AllSquares {
+		return pos.Y*ruleSet.BoardDim + pos.X + 1, true
+	}
+	return pos.Y*ruleSet.squaresPerRow() + pos.X/2 + 1, true
+}
+
+// SquarePos returns the position of a numbered square.
+func (ruleSet *RuleSet) SquarePos(square int) (pos Pos, found bool) {
+	perRow := ruleSet.squaresPerRow()
+	if square < 1 || perRow*ruleSet.BoardDim < square {
+		return NO_POS, false
+	}
+	y, index := (square-1)/perRow, (square-1)%perRow
+	if ruleSet.AllSquares {
+		return ruleSet.notationPos(Pos{index, y}), true
+	}
+	return ruleSet.notationPos(Pos{2*index + (y+1)%2, y}), true
+}
+
+// notationPos turns the board around for rule sets with NotationWhiteFirst.
+// Turning it around again gives back the same position.
+func (ruleSet *RuleSet) notationPos(pos Pos) Pos {
+	if !ruleSet.NotationWhiteFirst {
+		return pos
+	}
+	return Pos{ruleSet.BoardDim - 1 - pos.X, ruleSet.BoardDim - 1 - pos.Y}
+}
+
+func (ruleSet *RuleSet) squaresPerRow() int {
+	if ruleSet.AllSquares {
+		return ruleSet.BoardDim
+	}
+	return ruleSet.BoardDim / 2
+}
+
+func (ruleSet *RuleSet) FormatPdnMove(move PdnMove) string {
+	separator := NOTATION_MOVE_SEP
+	if move.Capture {
+		separator = NOTATION_CAPTURE_SEP
+	}
+	squares := make([]string, 0, len(move.Path))
+	for _, pos := range move.Path {
+		square, _ := ruleSet.Square(pos)
+		squares = append(squares, strconv.Itoa(square))
+	}
+	return strings.Join(squares, separator)
+}
+
+// ParsePdnMove reads a turn written in square notation. It does not check
+// that the turn is legal.
+func (ruleSet *RuleSet) ParsePdnMove(notation string) (move PdnMove, err error) {
+	if !notationRegexp.MatchString(notation) {
+		return PdnMove{}, errors.New(fmt.Sprintf("invalid PDN move: %s", notation))
+	}
+	move.Capture = strings.Contains(notation, NOTATION_CAPTURE_SEP)
+	separator := NOTATION_MOVE_SEP
+	if move.Capture {
+		separator = NOTATION_CAPTURE_SEP
+	}
+	for _, square := range strings.Split(notation, separator) {
+		number, _ := strconv.Atoi(square)
+		pos, found := ruleSet.SquarePos(number)
+		if !found {
+			return PdnMove{}, errors.New(fmt.Sprintf("invalid square %s in PDN move: %s", square, notation))
+		}
+		move.Path = append(move.Path, pos)
+	}
+	return move, nil
+}
@@ -0,0 +1,72 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func requireSquare(t *testing.T, expected int, ruleSet *RuleSet, pos Pos) {
+	square, found := ruleSet.Square(pos)
+	require.True(t, found)
+	require.Equal(t, expected, square)
+}
+
+func TestSquareNumbering(t *testing.T) {
+	requireSquare(t, 1, English, Pos{1, 0})
+	requireSquare(t, 5, English, Pos{0, 1})
+	requireSquare(t, 32, English, Pos{6, 7})
+	// international squares are numbered from the red side
+	requireSquare(t, 1, International, Pos{8, 9})
+	requireSquare(t, 46, International, Pos{9, 0})
+	requireSquare(t, 64, Turkish, Pos{7, 7})
+	for _, ruleSet := range []*RuleSet{English, International, Turkish} {
+		square := 1
+		for y := 0; y < ruleSet.BoardDim; y++ {
+			for x := 0; x < ruleSet.BoardDim; x++ {
+				if !ruleSet.Usable(Pos{x, y}) {
+					continue
+				}
+				expected := square
+				if ruleSet.NotationWhiteFirst {
+					expected = ruleSet.BoardDim*ruleSet.BoardDim/2 + 1 - square
+				}
+				requireSquare(t, expected, ruleSet, Pos{x, y})
+				pos, found := ruleSet.SquarePos(expected)
+				require.True(t, found)
+				require.Equal(t, Pos{x, y}, pos)
+				square++
+			}
+		}
+		_, found := ruleSet.SquarePos(square)
+		require.False(t, found)
+		_, found = ruleSet.SquarePos(0)
+		require.False(t, found)
+	}
+}
+
+func TestSquareOfUnusablePos(t *testing.T) {
+	_, found := English.Square(Pos{0, 0})
+	require.False(t, found)
+	_, found = English.Square(Pos{1, 8})
+	require.False(t, found)
+}
+
+func TestParsePdnMove(t *testing.T) {
+	move, err := English.ParsePdnMove("11-15")
+	require.Nil(t, err)
+	require.Equal(t, PdnMove{Path: []Pos{{5, 2}, {4, 3}}}, move)
+	require.Equal(t, "11-15", English.FormatPdnMove(move))
+
+	move, err = English.ParsePdnMove("15x24x31")
+	require.Nil(t, err)
+	require.Equal(t, PdnMove{Path: []Pos{{4, 3}, {6, 5}, {4, 7}}, Capture: true}, move)
+	require.Equal(t, "15x24x31", English.FormatPdnMove(move))
+
+	for _, wrong := range []string{"11", "11-15-19", "11-15x19", "x11", "11 15", "0-4", "11-33"} {
+		_, err = English.ParsePdnMove(wrong)
+		require.NotNil(t, err, wrong)
+	}
+	_, err = International.ParsePdnMove("46-41")
+	require.Nil(t, err)
+}
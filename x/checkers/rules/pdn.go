@@ -0,0 +1,269 @@
+package rules
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// PDN, the Portable Draughts Notation, writes each turn in square notation.
+const (
+	PDN_BLACK_WINS = "1-0"
+	PDN_RED_WINS   = "0-1"
+	PDN_DRAW       = "1/2-1/2"
+	PDN_ONGOING    = "*"
+
+	PDN_TAG_EVENT     = "Event"
+	PDN_TAG_BLACK     = "Black"
+	PDN_TAG_WHITE     = "White"
+	PDN_TAG_RESULT    = "Result"
+	PDN_TAG_GAME_TYPE = "GameType"
+	PDN_TAG_VARIANT   = "Variant"
+	PDN_TAG_FEN       = "FEN"
+
+	pdnLineLength = 80
+)
+
+// PdnResults maps the winner of a game to its PDN result, which gives the
+// score of the player moving first before that of the other one.
+var PdnResults = map[Player]string{
+	BLACK_PLAYER: PDN_BLACK_WINS,
+	RED_PLAYER:   PDN_RED_WINS,
+	DRAW_PLAYER:  PDN_DRAW,
+	NO_PLAYER:    PDN_ONGOING,
+}
+
+type PdnTag struct {
+	Name  string
+	Value string
+}
+
+// Pdn is a single game in PDN. Its moves use the square numbering of its rule
+// set.
+type Pdn struct {
+	Tags    []PdnTag
+	RuleSet *RuleSet
+	Moves   []PdnMove
+	Result  string
+}
+
+// IllegalMoveError reports the first move of a PDN game that could not be
+// played.
+type IllegalMoveError struct {
+	// Ply counts the turns played before this one
+	Ply  int
+	Move string
+	Err  error
+}
+
+func (err *IllegalMoveError) Error() string {
+	dots := "."
+	if err.Ply%2 == 1 {
+		dots = "..."
+	}
+	return fmt.Sprintf("illegal move %d%s %s: %v", err.Ply/2+1, dots, err.Move, err.Err)
+}
+
+func (err *IllegalMoveError) Unwrap() error {
+	return err.Err
+}
+
+// Tag returns the value of the first tag with that name, or "".
+func (pdn *Pdn) Tag(name string) string {
+	for _, tag := range pdn.Tags {
+		if tag.Name == name {
+			return tag.Value
+		}
+	}
+	return ""
+}
+
+func (pdn *Pdn) String() string {
+	var buf strings.Builder
+	for _, tag := range pdn.Tags {
+		buf.WriteString(fmt.Sprintf("[%s %s]\n", tag.Name, strconv.Quote(tag.Value)))
+	}
+	buf.WriteString("\n")
+	tokens := make([]string, 0, len(pdn.Moves)*3/2+2)
+	for i, move := range pdn.Moves {
+		ply := pdn.firstPly() + i
+		if ply%2 == 0 {
+			tokens = append(tokens, fmt.Sprintf("%d.", ply/2+1))
+		} else if i == 0 {
+			tokens = append(tokens, fmt.Sprintf("%d...", ply/2+1))
+		}
+		tokens = append(tokens, pdn.RuleSet.FormatPdnMove(move))
+	}
+	result := pdn.Result
+	if result == "" {
+		result = PDN_ONGOING
+	}
+	tokens = append(tokens, result)
+	lineLength := 0
+	for i, token := range tokens {
+		if 0 < i && pdnLineLength < lineLength+1+len(token) {
+			buf.WriteString("\n")
+			lineLength = 0
+		} else if 0 < i {
+			buf.WriteString(" ")
+			lineLength++
+		}
+		buf.WriteString(token)
+		lineLength += len(token)
+	}
+	buf.WriteString("\n")
+	return buf.String()
+}
+
+// firstPly is 1 when the FEN tag gives the move to the player who does not
+// move first, that is to red.
+func (pdn *Pdn) firstPly() int {
+	if strings.HasPrefix(pdn.Tag(PDN_TAG_FEN), pdn.RuleSet.fenColor(RED_PLAYER)+FEN_SEP) {
+		return 1
+	}
+	return 0
+}
+
+// Replay plays the moves from the position in the FEN tag, else from the
+// start position of the rule set. On an illegal move, it returns the game as
+// it was before that move along with an *IllegalMoveError.
+func (pdn *Pdn) Replay() (*Game, error) {
+	game := NewGame(pdn.RuleSet)
+	if fen := pdn.Tag(PDN_TAG_FEN); fen != "" {
+		var err error
+		game, err = ParseFen(pdn.RuleSet, fen)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for i, move := range pdn.Moves {
+		ply := pdn.firstPly() + i
+		sequence, err := game.matchPdnMove(move)
+		if err == nil {
+			_, err = game.MoveSequence(sequence.Path)
+		}
+		if err != nil {
+			return game, &IllegalMoveError{Ply: ply, Move: pdn.RuleSet.FormatPdnMove(move), Err: err}
+		}
+	}
+	return game, nil
+}
+
+// matchPdnMove finds the legal sequence of the player to move that the move
+// stands for. A capture may be abbreviated to its start and end squares when
+// that is unambiguous.
+func (game *Game) matchPdnMove(move PdnMove) (sequence Sequence, err error) {
+	matches := []Sequence{}
+	for _, candidate := range game.LegalSequences(game.Turn) {
+		if len(candidate.Captured) > 0 != move.Capture {
+			continue
+		}
+		if samePath(candidate.Path, move.Path) {
+			return candidate, nil
+		}
+		if move.Capture && len(move.Path) == 2 &&
+			candidate.Path[0] == move.Path[0] && candidate.Path[len(candidate.Path)-1] == move.Path[1] {
+			matches = append(matches, candidate)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		if game.Winner() != NO_PLAYER {
+			return Sequence{}, errors.New("game is over")
+		}
+		return Sequence{}, errors.New("no such move")
+	case 1:
+		return matches[0], nil
+	default:
+		return Sequence{}, errors.New("ambiguous capture")
+	}
+}
+
+var (
+	pdnTagRegexp     = regexp.MustCompile(`^\[(\w+)\s+("(?:[^"\\]|\\.)*")\s*\]$`)
+	pdnCommentRegexp = regexp.MustCompile(`\{[^}]*\}|\([^)]*\)`)
+	pdnNumberRegexp  = regexp.MustCompile(`^\d+\.(\.\.)?`)
+	pdnResults       = map[string]bool{
+		PDN_BLACK_WINS: true, PDN_RED_WINS: true, PDN_DRAW: true, PDN_ONGOING: true,
+		"2-0": true, "0-2": true, "1-1": true, "0-0": true,
+	}
+)
+
+// ParsePdn reads the first game of a PDN text. Its rule set comes from the
+// Variant tag, else from the GameType tag, and defaults to English draughts.
+// Comments and variations are skipped, and the moves are not checked for
+// legality.
+func ParsePdn(text string) (*Pdn, error) {
+	pdn := &Pdn{Tags: []PdnTag{}, Moves: []PdnMove{}, Result: PDN_ONGOING}
+	lines := strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+	movetextStart := len(lines)
+	for i, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		if !strings.HasPrefix(line, "[") {
+			movetextStart = i
+			break
+		}
+		match := pdnTagRegexp.FindStringSubmatch(line)
+		if match == nil {
+			return nil, errors.New(fmt.Sprintf("invalid PDN tag: %s", line))
+		}
+		value, err := strconv.Unquote(match[2])
+		if err != nil {
+			return nil, errors.New(fmt.Sprintf("invalid PDN tag: %s", line))
+		}
+		pdn.Tags = append(pdn.Tags, PdnTag{Name: match[1], Value: value})
+	}
+	ruleSet, err := pdnRuleSet(pdn)
+	if err != nil {
+		return nil, err
+	}
+	pdn.RuleSet = ruleSet
+
+	movetext := pdnCommentRegexp.ReplaceAllString(strings.Join(lines[movetextStart:], " "), " ")
+	for _, token := range strings.Fields(movetext) {
+		if pdnResults[token] {
+			pdn.Result = token
+			break
+		}
+		token = pdnNumberRegexp.ReplaceAllString(token, "")
+		token = strings.TrimRight(token, "!?")
+		if token == "" {
+			continue
+		}
+		move, err := ruleSet.ParsePdnMove(token)
+		if err != nil {
+			return nil, err
+		}
+		pdn.Moves = append(pdn.Moves, move)
+	}
+	return pdn, nil
+}
+
+func pdnRuleSet(pdn *Pdn) (*RuleSet, error) {
+	if variant := pdn.Tag(PDN_TAG_VARIANT); variant != "" {
+		ruleSet, found := GetRuleSet(variant)
+		if !found {
+			return nil, errors.New(fmt.Sprintf("unknown variant: %s", variant))
+		}
+		return ruleSet, nil
+	}
+	if gameType := pdn.Tag(PDN_TAG_GAME_TYPE); gameType != "" {
+		// the type may be followed by board details
+		number, err := strconv.Atoi(strings.Split(gameType, ",")[0])
+		if err != nil {
+			return nil, errors.New(fmt.Sprintf("invalid game type: %s", gameType))
+		}
+		for _, ruleSet := range ruleSets {
+			if number != 0 && ruleSet.PdnGameType == number {
+				return ruleSet, nil
+			}
+		}
+		return nil, errors.New(fmt.Sprintf("unknown game type: %s", gameType))
+	}
+	return English, nil
+}
@@ -0,0 +1,155 @@
+package rules
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const pdnOpening = `[Event "opening"]
+[Black "alice"]
+[White "bob"]
+[Result "*"]
+[GameType "21"]
+
+1. 11-15 23-19 {the old fourteenth} 2. 8-11 22-18 3. 15x22 25x18 *
+`
+
+func TestParsePdn(t *testing.T) {
+	pdn, err := ParsePdn(pdnOpening)
+	require.Nil(t, err)
+
+	require.Equal(t, "alice", pdn.Tag(PDN_TAG_BLACK))
+	require.Equal(t, "bob", pdn.Tag(PDN_TAG_WHITE))
+	require.Same(t, English, pdn.RuleSet)
+	require.Equal(t, PDN_ONGOING, pdn.Result)
+	require.Equal(t, []PdnMove{
+		{Path: []Pos{{5, 2}, {4, 3}}},
+		{Path: []Pos{{4, 5}, {5, 4}}},
+		{Path: []Pos{{6, 1}, {5, 2}}},
+		{Path: []Pos{{2, 5}, {3, 4}}},
+		{Path: []Pos{{4, 3}, {2, 5}}, Capture: true},
+		{Path: []Pos{{1, 6}, {3, 4}}, Capture: true},
+	}, pdn.Moves)
+}
+
+func TestPdnStringRoundTrip(t *testing.T) {
+	pdn, err := ParsePdn(pdnOpening)
+	require.Nil(t, err)
+
+	require.Equal(t, `[Event "opening"]
+[Black "alice"]
+[White "bob"]
+[Result "*"]
+[GameType "21"]
+
+1. 11-15 23-19 2. 8-11 22-18 3. 15x22 25x18 *
+`, pdn.String())
+	reparsed, err := ParsePdn(pdn.String())
+	require.Nil(t, err)
+	require.Equal(t, pdn, reparsed)
+}
+
+func TestPdnReplay(t *testing.T) {
+	pdn, err := ParsePdn(pdnOpening)
+	require.Nil(t, err)
+
+	game, err := pdn.Replay()
+	require.Nil(t, err)
+	require.Equal(t, "*b*b*b*b|b*b*b***|*b*b*b*b|********|***r*r**|r*****r*|***r*r*r|r*r*r*r*", game.String())
+	require.Equal(t, BLACK_PLAYER, game.Turn)
+}
+
+func TestPdnReplayReportsFirstIllegalMove(t *testing.T) {
+	pdn, err := ParsePdn("1. 11-15 23-19 2. 8-11 22-18 3. 9-13 26-22 1-0")
+	require.Nil(t, err)
+	require.Equal(t, PDN_BLACK_WINS, pdn.Result)
+
+	game, err := pdn.Replay()
+	var illegal *IllegalMoveError
+	require.True(t, errors.As(err, &illegal))
+	require.Equal(t, 4, illegal.Ply)
+	require.EqualError(t, err, "illegal move 3. 9-13: no such move")
+	// the game stops before the illegal move
+	require.Len(t, game.Pieces, 24)
+	require.Equal(t, BLACK_PLAYER, game.Turn)
+}
+
+func TestPdnAbbreviatedCapture(t *testing.T) {
+	game, err := Parse("********|********|*b******|**r*****|********|****r***|********|r*******")
+	require.Nil(t, err)
+
+	sequence, err := game.matchPdnMove(PdnMove{Path: []Pos{{1, 2}, {5, 6}}, Capture: true})
+	require.Nil(t, err)
+	require.Equal(t, []Pos{{1, 2}, {3, 4}, {5, 6}}, sequence.Path)
+	_, err = game.matchPdnMove(PdnMove{Path: []Pos{{1, 2}, {3, 4}}, Capture: true})
+	require.EqualError(t, err, "no such move")
+}
+
+func TestParsePdnErrors(t *testing.T) {
+	_, err := ParsePdn("[Variant \"chess\"]\n\n1. e2-e4 *")
+	require.EqualError(t, err, "unknown variant: chess")
+	_, err = ParsePdn("1. 11-33 *")
+	require.EqualError(t, err, "invalid square 33 in PDN move: 11-33")
+	_, err = ParsePdn("1. 11=15 *")
+	require.EqualError(t, err, "invalid PDN move: 11=15")
+
+	pdn, err := ParsePdn("[GameType \"20,W,10,10,N2,0\"]\n\n1. 31-26 *")
+	require.Nil(t, err)
+	require.Same(t, International, pdn.RuleSet)
+}
+
+func TestPdnFromFen(t *testing.T) {
+	pdn, err := ParsePdn(`[FEN "W:W18,K31:B1,14"]
+
+1... 18x9 2. 1-5 31-27 *`)
+	require.Nil(t, err)
+
+	game, err := pdn.Replay()
+	require.Nil(t, err)
+	require.Equal(t, "B:W9,K27:B5", game.Fen())
+	require.Equal(t, `[FEN "W:W18,K31:B1,14"]
+
+1... 18x9 2. 1-5 31-27 *
+`, pdn.String())
+
+	pdn.Moves[2].Path[1] = pdn.Moves[2].Path[0]
+	_, err = pdn.Replay()
+	require.EqualError(t, err, "illegal move 2... 31-31: no such move")
+}
+
+const pdnInternational = `[Event "club game"]
+[White "white"]
+[Black "black"]
+[Result "*"]
+[GameType "20"]
+
+1. 32-28 19-23 2. 28x19 14x23 3. 37-32 10-14 4. 32-28 23x32 5. 38x27 *
+`
+
+func TestInternationalPdnHasWhiteMovingFirst(t *testing.T) {
+	pdn, err := ParsePdn(pdnInternational)
+	require.Nil(t, err)
+	require.Same(t, International, pdn.RuleSet)
+	// white is the black player of the rule set, on the other side of the board
+	require.Equal(t, PdnMove{Path: []Pos{{6, 3}, {5, 4}}}, pdn.Moves[0])
+
+	game, err := pdn.Replay()
+	require.Nil(t, err)
+	require.Equal(t, RED_PLAYER, game.Turn)
+	require.Equal(t, "B:W27,31,33,34,35,36,39,40,41,42,43,44,45,46,47,48,49,50:B1,2,3,4,5,6,7,8,9,11,12,13,14,15,16,17,18,20", game.Fen())
+	require.Equal(t, pdnInternational, pdn.String())
+}
+
+func TestInternationalPdnFromFen(t *testing.T) {
+	pdn, err := ParsePdn(`[GameType "20"]
+[FEN "B:W27,31:B1,14"]
+
+5... 14-19 6. 27-21 *`)
+	require.Nil(t, err)
+	game, err := pdn.Replay()
+	require.Nil(t, err)
+	require.Equal(t, RED_PLAYER, game.Turn)
+	require.Equal(t, "B:W21,31:B1,19", game.Fen())
+}
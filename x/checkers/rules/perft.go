@@ -0,0 +1,72 @@
+package rules
+
+import (
+	"errors"
+	"fmt"
+)
+
+// PerftDivision is the number of leaves found below one of the turns
+// available at the root.
+type PerftDivision struct {
+	Sequence Sequence
+	Nodes    uint64
+}
+
+// Perft counts the positions reached after exactly depth turns, a whole
+// capture sequence being a single turn. Every legal sequence is played
+// through MoveSequence, which checks it again, so that a disagreement between
+// the listing and the checking of moves is reported as an error.
+func (game *Game) Perft(depth int) (nodes uint64, err error) {
+	if depth <= 0 {
+		return 1, nil
+	}
+	for _, sequence := range game.LegalSequences(game.Turn) {
+		if depth == 1 {
+			nodes++
+			continue
+		}
+		child, err := game.perftChild(sequence)
+		if err != nil {
+			return 0, err
+		}
+		childNodes, err := child.Perft(depth - 1)
+		if err != nil {
+			return 0, err
+		}
+		nodes += childNodes
+	}
+	return nodes, nil
+}
+
+// PerftDivide runs Perft below each turn of the root, in the order of
+// LegalSequences.
+func (game *Game) PerftDivide(depth int) ([]PerftDivision, error) {
+	if depth <= 0 {
+		return nil, errors.New(fmt.Sprintf("invalid perft depth: %d", depth))
+	}
+	divisions := []PerftDivision{}
+	for _, sequence := range game.LegalSequences(game.Turn) {
+		child, err := game.perftChild(sequence)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := child.Perft(depth - 1)
+		if err != nil {
+			return nil, err
+		}
+		divisions = append(divisions, PerftDivision{Sequence: sequence, Nodes: nodes})
+	}
+	return divisions, nil
+}
+
+func (game *Game) perftChild(sequence Sequence) (*Game, error) {
+	child := game.Copy()
+	captured, err := child.MoveSequence(sequence.Path)
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("legal sequence %v rejected: %v", sequence.Path, err))
+	}
+	if len(captured) != len(sequence.Captured) || child.Turn == game.Turn {
+		return nil, errors.New(fmt.Sprintf("legal sequence %v played differently: captured %v", sequence.Path, captured))
+	}
+	return child, nil
+}
@@ -0,0 +1,49 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// published perft numbers of English draughts from the start position
+var englishPerft = []uint64{1, 7, 49, 302, 1469, 7361, 36768, 179740}
+
+func TestPerftEnglishStart(t *testing.T) {
+	for depth, expected := range englishPerft {
+		nodes, err := New().Perft(depth)
+		require.Nil(t, err)
+		require.Equal(t, expected, nodes, "depth %d", depth)
+	}
+}
+
+func TestPerftDivideAddsUp(t *testing.T) {
+	divisions, err := New().PerftDivide(4)
+	require.Nil(t, err)
+	require.Len(t, divisions, 7)
+	total := uint64(0)
+	for _, division := range divisions {
+		total += division.Nodes
+	}
+	require.Equal(t, englishPerft[4], total)
+	require.Equal(t, []Pos{{1, 2}, {0, 3}}, divisions[0].Sequence.Path)
+}
+
+func TestPerftGameOver(t *testing.T) {
+	game, err := ParseFen(English, "B:W18:B")
+	require.Nil(t, err)
+
+	nodes, err := game.Perft(3)
+	require.Nil(t, err)
+	require.Equal(t, uint64(0), nodes)
+	_, err = game.PerftDivide(0)
+	require.NotNil(t, err)
+}
+
+func TestPerftOtherRuleSets(t *testing.T) {
+	for _, ruleSet := range []*RuleSet{International, Turkish} {
+		nodes, err := NewGame(ruleSet).Perft(3)
+		require.Nil(t, err)
+		require.Less(t, uint64(0), nodes)
+	}
+}
@@ -0,0 +1,181 @@
+package rules
+
+import (
+	"fmt"
+	"sort"
+)
+
+// EndCondition decides the outcome of a game whose player to move cannot
+// move, either for lack of pieces or because every piece is blocked.
+type EndCondition int
+
+const (
+	STUCK_PLAYER_LOSES EndCondition = iota
+	STUCK_PLAYER_WINS
+)
+
+// RuleSet describes the board and how pieces move, capture and promote in
+// one variant of draughts, as well as how the game ends.
+type RuleSet struct {
+	Name     string
+	BoardDim int
+	// AllSquares plays on every square rather than on the dark ones only
+	AllSquares bool
+	// StartRows is how many rows each player fills at the start, after
+	// leaving StartRowOffset rows empty on their side
+	StartRows      int
+	StartRowOffset int
+	// ManSteps and ManJumps are the directions in which men move and capture
+	ManSteps map[Player][]Pos
+	ManJumps map[Player][]Pos
+	// KingDirections are the directions in which kings move and capture
+	KingDirections []Pos
+	// FlyingKings lets kings move, and jump to, any distance along a line
+	FlyingKings bool
+	// MajorityCapture makes the sequence that takes the most pieces
+	// compulsory, otherwise any capture can be chosen
+	MajorityCapture bool
+	// DeferRemoval leaves captured pieces on the board until the sequence is
+	// complete, so they can be jumped only once and they block the way.
+	// Otherwise each piece is removed as soon as it is jumped.
+	DeferRemoval bool
+	// PromotionEndsCapture stops a capture sequence on the man that reaches
+	// the last row. Otherwise the man only becomes a king if its turn ends
+	// there.
+	PromotionEndsCapture bool
+	EndCondition         EndCondition
+	// Bitboards tells that pieces move as in English draughts on the 32 dark
+	// squares of an 8x8 board, so that games keep their pieces in a Bitboard
+	// as well to find who can move
+	Bitboards bool
+	// PdnGameType is the number of the variant in the GameType tag of PDN, 0
+	// when it has none
+	PdnGameType int
+	// NotationWhiteFirst tells that, in the square notation, FEN and PDN of
+	// the variant, white moves first from the highest squares. The black
+	// player, who moves first here, is then called white and the squares are
+	// numbered from the side of the red player.
+	NotationWhiteFirst bool
+}
+
+var ruleSets = map[string]*RuleSet{}
+
+// Register makes a rule set available by its name. It panics if the name is
+// already taken.
+func Register(ruleSet *RuleSet) {
+	if _, found := ruleSets[ruleSet.Name]; found {
+		panic(fmt.Sprintf("rule set already registered: %s", ruleSet.Name))
+	}
+	ruleSets[ruleSet.Name] = ruleSet
+}
+
+// GetRuleSet finds a registered rule set by name, the empty name being
+// English draughts.
+func GetRuleSet(name string) (ruleSet *RuleSet, found bool) {
+	if name == "" {
+		name = ENGLISH
+	}
+	ruleSet, found = ruleSets[name]
+	return ruleSet, found
+}
+
+// RuleSetNames lists the names of the registered rule sets in alphabetical
+// order.
+func RuleSetNames() []string {
+	names := make([]string, 0, len(ruleSets))
+	for name := range ruleSets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// MaxBoardDim is the size of the largest board of all registered rule sets.
+func MaxBoardDim() int {
+	max := 0
+	for _, ruleSet := range ruleSets {
+		if max < ruleSet.BoardDim {
+			max = ruleSet.BoardDim
+		}
+	}
+	return max
+}
+
+func (ruleSet *RuleSet) Usable(pos Pos) bool {
+	return 0 <= pos.X && pos.X < ruleSet.BoardDim &&
+		0 <= pos.Y && pos.Y < ruleSet.BoardDim &&
+		(ruleSet.AllSquares || (pos.X+pos.Y)%2 == 1)
+}
+
+// Promotes tells whether a man of the player becomes a king on pos.
+func (ruleSet *RuleSet) Promotes(player Player, pos Pos) bool {
+	return (player == BLACK_PLAYER && pos.Y == ruleSet.BoardDim-1) ||
+		(player == RED_PLAYER && pos.Y == 0)
+}
+
+// StartPieces lists the men on the board at the start of a game.
+func (ruleSet *RuleSet) StartPieces() map[Pos]Piece {
+	pieces := make(map[Pos]Piece)
+	for y := 0; y < ruleSet.BoardDim; y++ {
+		for x := 0; x < ruleSet.BoardDim; x++ {
+			pos := Pos{X: x, Y: y}
+			if !ruleSet.Usable(pos) {
+				continue
+			}
+			fromEdge := y
+			player := BLACK_PLAYER
+			if ruleSet.BoardDim/2 <= y {
+				fromEdge = ruleSet.BoardDim - 1 - y
+				player = RED_PLAYER
+			}
+			if ruleSet.StartRowOffset <= fromEdge && fromEdge < ruleSet.StartRowOffset+ruleSet.StartRows {
+				pieces[pos] = Piece{player, false}
+			}
+		}
+	}
+	return pieces
+}
+
+// StuckWinner is the winner when the player to move cannot move.
+func (ruleSet *RuleSet) StuckWinner(stuck Player) Player {
+	switch ruleSet.EndCondition {
+	case STUCK_PLAYER_WINS:
+		return stuck
+	default:
+		return Opponents[stuck]
+	}
+}
+
+var forwardDiagonals = map[Player][]Pos{
+	BLACK_PLAYER: {{-1, 1}, {1, 1}},
+	RED_PLAYER:   {{-1, -1}, {1, -1}},
+}
+
+var allDiagonals = []Pos{{-1, -1}, {1, -1}, {-1, 1}, {1, 1}}
+
+var forwardAndSideways = map[Player][]Pos{
+	BLACK_PLAYER: {{-1, 0}, {1, 0}, {0, 1}},
+	RED_PLAYER:   {{-1, 0}, {1, 0}, {0, -1}},
+}
+
+var allOrthogonals = []Pos{{0, -1}, {-1, 0}, {1, 0}, {0, 1}}
+
+func (pos Pos) plus(dir Pos) Pos {
+	return Pos{pos.X + dir.X, pos.Y + dir.Y}
+}
+
+// directionTo is the unit step that leads from pos towards dst along a line.
+func (pos Pos) directionTo(dst Pos) Pos {
+	return Pos{sign(dst.X - pos.X), sign(dst.Y - pos.Y)}
+}
+
+func sign(n int) int {
+	switch {
+	case n < 0:
+		return -1
+	case 0 < n:
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,54 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetRuleSet(t *testing.T) {
+	ruleSet, found := GetRuleSet("")
+	require.True(t, found)
+	require.Same(t, English, ruleSet)
+	ruleSet, found = GetRuleSet(INTERNATIONAL)
+	require.True(t, found)
+	require.Same(t, International, ruleSet)
+	_, found = GetRuleSet("chess")
+	require.False(t, found)
+	require.Contains(t, RuleSetNames(), ENGLISH)
+	require.Contains(t, RuleSetNames(), INTERNATIONAL)
+}
+
+func TestRegisterTwicePanics(t *testing.T) {
+	require.Panics(t, func() { Register(&RuleSet{Name: ENGLISH}) })
+}
+
+func TestEnglishStart(t *testing.T) {
+	game := NewGame(English)
+
+	require.Len(t, game.Pieces, 24)
+	require.Len(t, game.LegalMoves(BLACK_PLAYER), 7)
+	require.Equal(t, "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*", game.String())
+}
+
+func TestCustomRuleSetWithoutRegistering(t *testing.T) {
+	mini := &RuleSet{
+		Name:           "mini",
+		BoardDim:       6,
+		StartRows:      2,
+		ManSteps:       forwardDiagonals,
+		ManJumps:       forwardDiagonals,
+		KingDirections: allDiagonals,
+		EndCondition:   STUCK_PLAYER_LOSES,
+	}
+	game := NewGame(mini)
+
+	require.Len(t, game.Pieces, 12)
+	require.Equal(t, "*b*b*b|b*b*b*|******|******|*r*r*r|r*r*r*", game.String())
+	parsed, err := ParseWithRuleSet(mini, game.String())
+	require.Nil(t, err)
+	require.Equal(t, game.Pieces, parsed.Pieces)
+	_, err = game.Move(Pos{0, 1}, Pos{1, 2})
+	require.Nil(t, err)
+	require.Equal(t, RED_PLAYER, game.Turn)
+}
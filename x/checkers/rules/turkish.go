@@ -0,0 +1,26 @@
+package rules
+
+const TURKISH = "turkish"
+
+// Turkish draughts on all 64 squares: men move and capture forwards and
+// sideways, kings fly in the four orthogonal directions, and the capture
+// taking the most pieces is compulsory. Each captured piece is removed as
+// soon as it is jumped.
+var Turkish = &RuleSet{
+	Name:            TURKISH,
+	BoardDim:        8,
+	AllSquares:      true,
+	StartRows:       2,
+	StartRowOffset:  1,
+	ManSteps:        forwardAndSideways,
+	ManJumps:        forwardAndSideways,
+	KingDirections:  allOrthogonals,
+	FlyingKings:     true,
+	MajorityCapture: true,
+	EndCondition:    STUCK_PLAYER_LOSES,
+	PdnGameType:     30,
+}
+
+func init() {
+	Register(Turkish)
+}
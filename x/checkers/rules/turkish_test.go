@@ -0,0 +1,107 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTurkishGame(pieces map[Pos]Piece) *Game {
+	return &Game{Pieces: pieces, Turn: BLACK_PLAYER, RuleSet: Turkish}
+}
+
+func TestTurkishStart(t *testing.T) {
+	game := NewGame(Turkish)
+
+	require.Len(t, game.Pieces, 32)
+	require.Len(t, game.LegalMoves(BLACK_PLAYER), 8)
+	require.Equal(t, "********|bbbbbbbb|bbbbbbbb|********|********|rrrrrrrr|rrrrrrrr|********", game.String())
+
+	parsed, err := ParseWithRuleSet(Turkish, game.String())
+	require.Nil(t, err)
+	require.Equal(t, game.Pieces, parsed.Pieces)
+	_, err = Parse(game.String())
+	require.EqualError(t, err, "invalid board, piece on unusable square: 1, 1")
+}
+
+func TestTurkishManMovesForwardAndSideways(t *testing.T) {
+	game := newTurkishGame(map[Pos]Piece{
+		{3, 3}: {BLACK_PLAYER, false},
+		{0, 7}: {RED_PLAYER, false},
+	})
+
+	require.ElementsMatch(t, []Move{
+		{Pos{3, 3}, Pos{2, 3}},
+		{Pos{3, 3}, Pos{4, 3}},
+		{Pos{3, 3}, Pos{3, 4}},
+	}, game.LegalMovesFrom(Pos{3, 3}))
+	_, err := game.Move(Pos{3, 3}, Pos{4, 4})
+	require.NotNil(t, err)
+	_, err = game.Move(Pos{3, 3}, Pos{3, 2})
+	require.NotNil(t, err)
+	_, err = game.Move(Pos{3, 3}, Pos{4, 3})
+	require.Nil(t, err)
+}
+
+func TestTurkishMajorityCapture(t *testing.T) {
+	game := newTurkishGame(map[Pos]Piece{
+		{1, 3}: {BLACK_PLAYER, false},
+		{2, 3}: {RED_PLAYER, false},
+		{3, 4}: {RED_PLAYER, false},
+		{1, 4}: {RED_PLAYER, false},
+		{7, 7}: {RED_PLAYER, false},
+	})
+
+	require.Equal(t, []Sequence{{
+		Path:     []Pos{{1, 3}, {3, 3}, {3, 5}},
+		Captured: []Pos{{2, 3}, {3, 4}},
+	}}, game.LegalSequences(BLACK_PLAYER))
+	_, err := game.Move(Pos{1, 3}, Pos{1, 5})
+	require.NotNil(t, err)
+	captured, err := game.MoveSequence([]Pos{{1, 3}, {3, 3}, {3, 5}})
+	require.Nil(t, err)
+	require.Equal(t, []Pos{{2, 3}, {3, 4}}, captured)
+	require.Equal(t, RED_PLAYER, game.Turn)
+	require.Len(t, game.Pieces, 3)
+}
+
+func TestTurkishKingCrossesCapturedSquare(t *testing.T) {
+	game := newTurkishGame(map[Pos]Piece{
+		{0, 3}: {BLACK_PLAYER, true},
+		{1, 3}: {RED_PLAYER, false},
+		{3, 5}: {RED_PLAYER, false},
+		{2, 6}: {RED_PLAYER, false},
+		{1, 1}: {RED_PLAYER, false},
+		{7, 0}: {RED_PLAYER, false},
+	})
+
+	// the square of the first captured piece is crossed again on the last hop
+	path := []Pos{{0, 3}, {3, 3}, {3, 6}, {1, 6}, {1, 0}}
+	captured, err := game.MoveSequence(path)
+	require.Nil(t, err)
+	require.Equal(t, []Pos{{1, 3}, {3, 5}, {2, 6}, {1, 1}}, captured)
+	require.Equal(t, Piece{BLACK_PLAYER, true}, game.Pieces[Pos{1, 0}])
+}
+
+func TestTurkishKingCannotTurnBack(t *testing.T) {
+	game := newTurkishGame(map[Pos]Piece{
+		{2, 0}: {BLACK_PLAYER, true},
+		{1, 0}: {RED_PLAYER, false},
+		{3, 0}: {RED_PLAYER, false},
+	})
+
+	for _, sequence := range game.LegalSequences(BLACK_PLAYER) {
+		require.Len(t, sequence.Captured, 1)
+	}
+}
+
+func TestTurkishPromotion(t *testing.T) {
+	game := newTurkishGame(map[Pos]Piece{
+		{4, 6}: {BLACK_PLAYER, false},
+		{0, 1}: {RED_PLAYER, false},
+	})
+
+	_, err := game.Move(Pos{4, 6}, Pos{4, 7})
+	require.Nil(t, err)
+	require.True(t, game.Pieces[Pos{4, 7}].King)
+}
@@ -0,0 +1,58 @@
+package rules
+
+import "fmt"
+
+// ZOBRIST_SEED fixes the keys of the Zobrist hash, so that every node
+// computes the same hash for the same position and hashes can be stored.
+// Changing it invalidates every stored hash.
+const ZOBRIST_SEED = uint64(0x9E3779B97F4A7C15)
+
+const (
+	// zobristMaxDim bounds the coordinates of the squares that get a key
+	zobristMaxDim = 256
+	// zobristTurn is the index of the key for red to move, after those of
+	// the pieces
+	zobristTurn = zobristMaxDim * zobristMaxDim * 4
+)
+
+// zobristKey derives the key at index from the seed with SplitMix64, which
+// depends neither on the platform nor on the Go version.
+func zobristKey(index int) uint64 {
+	z := ZOBRIST_SEED + uint64(index+1)*0x9E3779B97F4A7C15
+	z = (z ^ (z >> 30)) * 0xBF58476D1CE4E5B9
+	z = (z ^ (z >> 27)) * 0x94D049BB133111EB
+	return z ^ (z >> 31)
+}
+
+func zobristPieceKey(pos Pos, piece Piece) uint64 {
+	kind := 0
+	if piece.Player == RED_PLAYER {
+		kind = 1
+	}
+	if piece.King {
+		kind += 2
+	}
+	return zobristKey((pos.Y*zobristMaxDim+pos.X)*4 + kind)
+}
+
+// Hash is the Zobrist hash of the pieces and of the player to move. It is
+// computed from the pieces the first time, then Move, MoveSequence and
+// PlaySequence keep it up to date as they move, capture and promote pieces.
+// Code that changes Pieces directly must do so before the first call.
+func (game *Game) Hash() uint64 {
+	if !game.hashed {
+		game.piecesHash = 0
+		for pos, piece := range game.Pieces {
+			game.piecesHash ^= zobristPieceKey(pos, piece)
+		}
+		game.hashed = true
+	}
+	if game.Turn == RED_PLAYER {
+		return game.piecesHash ^ zobristKey(zobristTurn)
+	}
+	return game.piecesHash
+}
+
+func formatHash(hash uint64) string {
+	return fmt.Sprintf("%016x", hash)
+}
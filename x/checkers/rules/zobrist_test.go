@@ -0,0 +1,70 @@
+package rules
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// rehashed hashes the position of the game from scratch.
+func rehashed(game *Game) uint64 {
+	return (&Game{Pieces: game.Copy().Pieces, Turn: game.Turn, RuleSet: game.RuleSet}).Hash()
+}
+
+func TestHashIsFixed(t *testing.T) {
+	// stored hashes rely on these values never changing
+	require.Equal(t, uint64(0x17eb879aa804f9b9), New().Hash())
+	require.Equal(t, "17eb879aa804f9b9", New().PositionKey())
+}
+
+func TestHashDependsOnTurn(t *testing.T) {
+	game := New()
+	black := game.Hash()
+	game.Turn = RED_PLAYER
+	require.NotEqual(t, black, game.Hash())
+	game.Turn = BLACK_PLAYER
+	require.Equal(t, black, game.Hash())
+}
+
+func TestHashOfTranspositions(t *testing.T) {
+	first, second := New(), New()
+	for _, move := range [][2]Pos{{{1, 2}, {0, 3}}, {{6, 5}, {7, 4}}, {{3, 2}, {2, 3}}} {
+		_, err := first.Move(move[0], move[1])
+		require.Nil(t, err)
+	}
+	for _, move := range [][2]Pos{{{3, 2}, {2, 3}}, {{6, 5}, {7, 4}}, {{1, 2}, {0, 3}}} {
+		_, err := second.Move(move[0], move[1])
+		require.Nil(t, err)
+	}
+	require.Equal(t, first.String(), second.String())
+	require.Equal(t, first.Hash(), second.Hash())
+	require.NotEqual(t, New().Hash(), first.Hash())
+}
+
+// TestHashFollowsMoves checks the incremental hash against one computed from
+// scratch along random games, with captures and promotions, of each rule set.
+func TestHashFollowsMoves(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	for _, name := range RuleSetNames() {
+		ruleSet, _ := GetRuleSet(name)
+		for round := 0; round < 10; round++ {
+			game := NewGame(ruleSet)
+			game.Hash()
+			for ply := 0; ply < 150; ply++ {
+				require.Equal(t, rehashed(game), game.Hash(), game.String())
+				sequences := game.LegalSequences(game.Turn)
+				if len(sequences) == 0 {
+					break
+				}
+				sequence := sequences[random.Intn(len(sequences))]
+				if ply%2 == 0 {
+					_, err := game.MoveSequence(sequence.Path)
+					require.Nil(t, err)
+				} else {
+					game.PlaySequence(sequence)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package testutil
+
+import (
+	"github.com/bekauz/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// BankKeeper is an in-memory types.BankKeeper that keeps balances by bech32
+// address, module accounts included.
+type BankKeeper struct {
+	Balances map[string]sdk.Coins
+}
+
+var _ types.BankKeeper = &BankKeeper{}
+
+func NewBankKeeper() *BankKeeper {
+	return &BankKeeper{
+		Balances: make(map[string]sdk.Coins),
+	}
+}
+
+// Fund gives coins to the address, out of thin air.
+func (bank *BankKeeper) Fund(address string, amt sdk.Coins) {
+	bank.Balances[address] = bank.Balances[address].Add(amt...)
+}
+
+// ModuleBalance returns the coins held by the module account.
+func (bank *BankKeeper) ModuleBalance(module string) sdk.Coins {
+	return bank.Balances[authtypes.NewModuleAddress(module).String()]
+}
+
+func (bank *BankKeeper) SpendableCoins(_ sdk.Context, addr sdk.AccAddress) sdk.Coins {
+	return bank.Balances[addr.String()]
+}
+
+func (bank *BankKeeper) SendCoinsFromAccountToModule(_ sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	return bank.send(senderAddr, authtypes.NewModuleAddress(recipientModule), amt)
+}
+
+func (bank *BankKeeper) SendCoinsFromModuleToAccount(_ sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	return bank.send(authtypes.NewModuleAddress(senderModule), recipientAddr, amt)
+}
+
+func (bank *BankKeeper) send(from sdk.AccAddress, to sdk.AccAddress, amt sdk.Coins) error {
+	balance, negative := bank.Balances[from.String()].SafeSub(amt...)
+	if negative {
+		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "%s is smaller than %s", bank.Balances[from.String()], amt)
+	}
+	bank.Balances[from.String()] = balance
+	bank.Balances[to.String()] = bank.Balances[to.String()].Add(amt...)
+	return nil
+}
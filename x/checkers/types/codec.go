@@ -10,6 +10,11 @@ import (
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCreateGame{}, "checkers/CreateGame", nil)
 	cdc.RegisterConcrete(&MsgPlayMove{}, "checkers/PlayMove", nil)
+	cdc.RegisterConcrete(&MsgPlayMoves{}, "checkers/PlayMoves", nil)
+	cdc.RegisterConcrete(&MsgResign{}, "checkers/Resign", nil)
+	cdc.RegisterConcrete(&MsgOfferDraw{}, "checkers/OfferDraw", nil)
+	cdc.RegisterConcrete(&MsgAcceptDraw{}, "checkers/AcceptDraw", nil)
+	cdc.RegisterConcrete(&MsgDeclineDraw{}, "checkers/DeclineDraw", nil)
 	// this line is used by starport scaffolding # 2
 }
 
@@ -20,6 +25,21 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgPlayMove{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgPlayMoves{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgResign{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgOfferDraw{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgAcceptDraw{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgDeclineDraw{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -0,0 +1,66 @@
+package types
+
+// Scores, in hundredths, that a player earns from a game
+const (
+	EloScoreLoss uint64 = 0
+	EloScoreDraw uint64 = 50
+	EloScoreWin  uint64 = 100
+)
+
+// EloMaxRatingDifference caps the rating difference taken into account, so
+// that a strong player still gains something from beating a weak one.
+const EloMaxRatingDifference = 400
+
+// eloExpectedScores lists, for each expected score of the higher rated player
+// from 50 hundredths upwards, the largest rating difference that yields it.
+// It is precomputed from 1 / (1 + 10^(-difference/400)) so that validators
+// never rely on floating point.
+var eloExpectedScores = []uint64{
+	3, 10, 17, 24, 31, 38, 45, 52, 59, 66,
+	74, 81, 88, 96, 103, 111, 119, 126, 134, 143,
+	151, 159, 168, 177, 186, 195, 205, 214, 224, 235,
+	246, 257, 269, 281, 294, 308, 322, 338, 354, 372,
+	391, EloMaxRatingDifference,
+}
+
+// EloExpectedScore returns, in hundredths, the score the player rated
+// rating is expected to make against a player rated opponentRating.
+func EloExpectedScore(rating uint64, opponentRating uint64) uint64 {
+	higher, difference := true, rating-opponentRating
+	if rating < opponentRating {
+		higher, difference = false, opponentRating-rating
+	}
+	if difference > EloMaxRatingDifference {
+		difference = EloMaxRatingDifference
+	}
+	expected := EloScoreDraw
+	for _, threshold := range eloExpectedScores {
+		if difference <= threshold {
+			break
+		}
+		expected++
+	}
+	if !higher {
+		return EloScoreWin - expected
+	}
+	return expected
+}
+
+// EloRatingDelta returns how much the rating of a player, who made score
+// against their opponent, changes. The opponent's rating changes by the
+// opposite amount. Halves are rounded away from zero.
+func EloRatingDelta(rating uint64, opponentRating uint64, score uint64, kFactor uint64) int64 {
+	hundredths := int64(kFactor) * (int64(score) - int64(EloExpectedScore(rating, opponentRating)))
+	if hundredths < 0 {
+		return -((-hundredths + 50) / 100)
+	}
+	return (hundredths + 50) / 100
+}
+
+// ApplyEloRatingDelta moves the rating by delta, without going below zero.
+func ApplyEloRatingDelta(rating uint64, delta int64) uint64 {
+	if delta < 0 && rating < uint64(-delta) {
+		return 0
+	}
+	return uint64(int64(rating) + delta)
+}
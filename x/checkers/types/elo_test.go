@@ -0,0 +1,61 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/bekauz/checkers/x/checkers/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEloExpectedScore(t *testing.T) {
+	for _, tc := range []struct {
+		rating         uint64
+		opponentRating uint64
+		expected       uint64
+	}{
+		{rating: 1200, opponentRating: 1200, expected: 50},
+		{rating: 1203, opponentRating: 1200, expected: 50},
+		{rating: 1204, opponentRating: 1200, expected: 51},
+		{rating: 1200, opponentRating: 1204, expected: 49},
+		{rating: 1300, opponentRating: 1200, expected: 64},
+		{rating: 1200, opponentRating: 1300, expected: 36},
+		{rating: 1600, opponentRating: 1200, expected: 91},
+		{rating: 2800, opponentRating: 1200, expected: 91},
+		{rating: 0, opponentRating: 2800, expected: 9},
+	} {
+		require.Equal(t, tc.expected, types.EloExpectedScore(tc.rating, tc.opponentRating),
+			"%d against %d", tc.rating, tc.opponentRating)
+	}
+}
+
+func TestEloRatingDelta(t *testing.T) {
+	for _, tc := range []struct {
+		desc           string
+		rating         uint64
+		opponentRating uint64
+		score          uint64
+		delta          int64
+	}{
+		{desc: "equal win", rating: 1200, opponentRating: 1200, score: types.EloScoreWin, delta: 16},
+		{desc: "equal loss", rating: 1200, opponentRating: 1200, score: types.EloScoreLoss, delta: -16},
+		{desc: "equal draw", rating: 1200, opponentRating: 1200, score: types.EloScoreDraw, delta: 0},
+		{desc: "upset win", rating: 1200, opponentRating: 1300, score: types.EloScoreWin, delta: 20},
+		{desc: "expected win", rating: 1300, opponentRating: 1200, score: types.EloScoreWin, delta: 12},
+		{desc: "upset draw", rating: 1200, opponentRating: 1300, score: types.EloScoreDraw, delta: 4},
+		{desc: "capped win", rating: 2800, opponentRating: 1200, score: types.EloScoreWin, delta: 3},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			delta := types.EloRatingDelta(tc.rating, tc.opponentRating, tc.score, 32)
+			require.Equal(t, tc.delta, delta)
+			// the opponent sees the mirror image
+			opponentDelta := types.EloRatingDelta(tc.opponentRating, tc.rating, types.EloScoreWin-tc.score, 32)
+			require.Equal(t, -tc.delta, opponentDelta)
+		})
+	}
+}
+
+func TestApplyEloRatingDelta(t *testing.T) {
+	require.EqualValues(t, 1216, types.ApplyEloRatingDelta(1200, 16))
+	require.EqualValues(t, 1184, types.ApplyEloRatingDelta(1200, -16))
+	require.EqualValues(t, 0, types.ApplyEloRatingDelta(10, -16))
+}
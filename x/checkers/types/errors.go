@@ -18,4 +18,21 @@ var (
 	ErrCreatorNotPlayer     = sdkerrors.Register(ModuleName, 1107, "message sender is not the player")
 	ErrNotPlayerTurn        = sdkerrors.Register(ModuleName, 1108, "player tried to play out of turn")
 	ErrWrongMove            = sdkerrors.Register(ModuleName, 1109, "wrong move")
+	ErrGameFinished         = sdkerrors.Register(ModuleName, 1110, "game is already finished")
+	ErrInvalidGameStatus    = sdkerrors.Register(ModuleName, 1111, "game status is invalid")
+	ErrInvalidDeadline      = sdkerrors.Register(ModuleName, 1112, "deadline cannot be parsed: %s")
+	ErrInvalidWager         = sdkerrors.Register(ModuleName, 1113, "wager is invalid")
+	ErrBlackCannotPay       = sdkerrors.Register(ModuleName, 1114, "black cannot pay the wager")
+	ErrRedCannotPay         = sdkerrors.Register(ModuleName, 1115, "red cannot pay the wager")
+	ErrNothingToPay         = sdkerrors.Register(ModuleName, 1116, "there is nothing to pay, should not have been called")
+	ErrCannotRefundWager    = sdkerrors.Register(ModuleName, 1117, "cannot refund wager to: %s")
+	ErrCannotPayWinnings    = sdkerrors.Register(ModuleName, 1118, "cannot pay winnings to winner: %s")
+	ErrCannotFindWinner     = sdkerrors.Register(ModuleName, 1119, "cannot find winner by color: %s")
+	ErrThereIsNoWinner      = sdkerrors.Register(ModuleName, 1120, "there is no winner")
+	ErrWinnerNotParseable   = sdkerrors.Register(ModuleName, 1121, "winner is not parseable: %s")
+	ErrDrawAlreadyOffered   = sdkerrors.Register(ModuleName, 1122, "a draw is already offered")
+	ErrNoDrawOffer          = sdkerrors.Register(ModuleName, 1123, "no draw is offered")
+	ErrOwnDrawOffer         = sdkerrors.Register(ModuleName, 1124, "player cannot answer their own draw offer")
+	ErrInvalidVariant       = sdkerrors.Register(ModuleName, 1125, "variant is unknown: %s")
+	ErrInvalidStartPosition = sdkerrors.Register(ModuleName, 1126, "start position is invalid: %s")
 )
@@ -3,6 +3,7 @@ package types
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/bekauz/checkers/x/checkers/rules"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -21,18 +22,80 @@ func (storedGame StoredGame) GetRedAddress() (red sdk.AccAddress, err error) {
 	return red, sdkerrors.Wrapf(errRed, ErrInvalidRed.Error(), storedGame.Red)
 }
 
+func (storedGame StoredGame) GetRuleSet() (ruleSet *rules.RuleSet, err error) {
+	ruleSet, found := rules.GetRuleSet(storedGame.Variant)
+	if !found {
+		return nil, sdkerrors.Wrapf(ErrInvalidVariant, "%s", storedGame.Variant)
+	}
+	return ruleSet, nil
+}
+
 func (storedGame StoredGame) ParseGame() (game *rules.Game, err error) {
-	board, errBoard := rules.Parse(storedGame.Board)
+	ruleSet, err := storedGame.GetRuleSet()
+	if err != nil {
+		return nil, err
+	}
+	board, errBoard := rules.ParsePosition(ruleSet, storedGame.Board)
 	if errBoard != nil {
 		return nil, sdkerrors.Wrapf(errBoard, ErrGameNotParseable.Error())
 	}
-	board.Turn = rules.StringPieces[storedGame.Turn].Player
-	if board.Turn.Color == "" {
+	// a FEN board, as found in genesis, carries its own turn
+	turn := rules.StringPieces[storedGame.Turn].Player
+	if rules.IsFen(storedGame.Board) && storedGame.Turn == "" {
+		turn = board.Turn
+	}
+	if turn.Color == "" || (rules.IsFen(storedGame.Board) && turn != board.Turn) {
 		return nil, sdkerrors.Wrapf(errors.New(fmt.Sprintf("Turn: %s", storedGame.Turn)), ErrGameNotParseable.Error())
 	}
+	board.Turn = turn
+	board.History = append([]string(nil), storedGame.History...)
 	return board, nil
 }
 
+func (storedGame StoredGame) HasWager() bool {
+	return storedGame.Wager > 0
+}
+
+func (storedGame StoredGame) GetWagerCoin() (wager sdk.Coin) {
+	return sdk.NewCoin(storedGame.Denom, sdk.NewIntFromUint64(storedGame.Wager))
+}
+
+// HasPaidWager tells whether the player has escrowed its wager, which it does
+// on its first move.
+func (storedGame StoredGame) HasPaidWager(player rules.Player) bool {
+	switch player {
+	case rules.BLACK_PLAYER:
+		return storedGame.BlackPaid
+	case rules.RED_PLAYER:
+		return storedGame.RedPaid
+	default:
+		return false
+	}
+}
+
+// SetWagerPaid records that the player has escrowed its wager.
+func (storedGame *StoredGame) SetWagerPaid(player rules.Player) {
+	switch player {
+	case rules.BLACK_PLAYER:
+		storedGame.BlackPaid = true
+	case rules.RED_PLAYER:
+		storedGame.RedPaid = true
+	}
+}
+
+func (storedGame StoredGame) GetDeadlineAsTime() (deadline time.Time, err error) {
+	deadline, errDeadline := time.Parse(DeadlineLayout, storedGame.Deadline)
+	return deadline, sdkerrors.Wrapf(errDeadline, ErrInvalidDeadline.Error(), storedGame.Deadline)
+}
+
+func GetNextDeadline(ctx sdk.Context, maxTurnDuration time.Duration) time.Time {
+	return ctx.BlockTime().Add(maxTurnDuration)
+}
+
+func FormatDeadline(deadline time.Time) string {
+	return deadline.UTC().Format(DeadlineLayout)
+}
+
 func (storedGame StoredGame) Validate() (err error) {
 	_, err = storedGame.GetBlackAddress()
 	if err != nil {
@@ -43,6 +106,14 @@ func (storedGame StoredGame) Validate() (err error) {
 		return err
 	}
 	_, err = storedGame.ParseGame()
+	if err != nil {
+		return err
+	}
+	_, err = storedGame.ParseMoves()
+	if err != nil {
+		return err
+	}
+	_, err = storedGame.GetDeadlineAsTime()
 	return err
 }
 
@@ -62,6 +133,63 @@ func (storedGame StoredGame) GetPlayerAddress(color string) (address sdk.AccAddr
 	return address, found, nil
 }
 
+func (storedGame StoredGame) IsFinished() bool {
+	return storedGame.Winner != "" && storedGame.Winner != rules.PieceStrings[rules.NO_PLAYER]
+}
+
+func (storedGame StoredGame) IsDraw() bool {
+	return storedGame.Winner == rules.PieceStrings[rules.DRAW_PLAYER]
+}
+
+func (storedGame StoredGame) HasDrawOffer() bool {
+	return storedGame.DrawOffer != "" && storedGame.DrawOffer != rules.PieceStrings[rules.NO_PLAYER]
+}
+
+// GetPlayerColor returns the color played by the address. A player of both
+// colors plays the side whose turn it is.
+func (storedGame StoredGame) GetPlayerColor(address string) (player rules.Player, found bool) {
+	isBlack := storedGame.Black == address
+	isRed := storedGame.Red == address
+	switch {
+	case isBlack && isRed:
+		return rules.StringPieces[storedGame.Turn].Player, true
+	case isBlack:
+		return rules.BLACK_PLAYER, true
+	case isRed:
+		return rules.RED_PLAYER, true
+	default:
+		return rules.NO_PLAYER, false
+	}
+}
+
+func (storedGame StoredGame) HasStatus(status string) (bool, error) {
+	switch status {
+	case "":
+		return true, nil
+	case GameStatusActive:
+		return !storedGame.IsFinished(), nil
+	case GameStatusFinished:
+		return storedGame.IsFinished(), nil
+	default:
+		return false, sdkerrors.Wrapf(ErrInvalidGameStatus, "%s", status)
+	}
+}
+
 func (storedGame StoredGame) GetWinnerAddress() (address sdk.AccAddress, found bool, err error) {
 	return storedGame.GetPlayerAddress(storedGame.Winner)
 }
+
+// NormalizeBoard rewrites a board given as a FEN as a board string, and sets
+// the turn it carries.
+func (storedGame *StoredGame) NormalizeBoard() error {
+	if !rules.IsFen(storedGame.Board) {
+		return nil
+	}
+	game, err := storedGame.ParseGame()
+	if err != nil {
+		return err
+	}
+	storedGame.Board = game.String()
+	storedGame.Turn = rules.PieceStrings[game.Turn]
+	return nil
+}
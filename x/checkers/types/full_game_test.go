@@ -18,11 +18,12 @@ const (
 
 func GetStoredGame1() types.StoredGame {
 	return types.StoredGame{
-		Black: alice,
-		Red:   bob,
-		Index: "1",
-		Board: rules.New().String(),
-		Turn:  "b",
+		Black:    alice,
+		Red:      bob,
+		Index:    "1",
+		Board:    rules.New().String(),
+		Turn:     "b",
+		Deadline: types.DeadlineLayout,
 	}
 }
 
@@ -96,3 +97,32 @@ func TestCheckTampering(t *testing.T) {
 	require.EqualError(t, err, "game is not parseable: invalid board, invalid piece at 1, 0")
 	require.EqualError(t, storedGame.Validate(), err.Error())
 }
+
+func TestParseGameWithFenBoard(t *testing.T) {
+	storedGame := GetStoredGame1()
+	storedGame.Board = "W:W18,K31:B1,14"
+	storedGame.Turn = ""
+	game, err := storedGame.ParseGame()
+	require.Nil(t, err)
+	require.Equal(t, rules.RED_PLAYER, game.Turn)
+
+	storedGame.Turn = "b"
+	_, err = storedGame.ParseGame()
+	require.EqualError(t, err, "game is not parseable: Turn: b")
+
+	storedGame.Turn = ""
+	require.Nil(t, storedGame.NormalizeBoard())
+	require.Equal(t, "*b******|********|********|**b*****|***r****|********|********|****R***", storedGame.Board)
+	require.Equal(t, "r", storedGame.Turn)
+}
+
+func TestSetWagerPaid(t *testing.T) {
+	storedGame := GetStoredGame1()
+	require.False(t, storedGame.HasPaidWager(rules.BLACK_PLAYER))
+	require.False(t, storedGame.HasPaidWager(rules.RED_PLAYER))
+
+	storedGame.SetWagerPaid(rules.RED_PLAYER)
+	require.False(t, storedGame.HasPaidWager(rules.BLACK_PLAYER))
+	require.True(t, storedGame.HasPaidWager(rules.RED_PLAYER))
+	require.True(t, storedGame.RedPaid)
+}
@@ -11,9 +11,12 @@ const DefaultIndex uint64 = 1
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		SystemInfo: SystemInfo{
-			NextId: uint64(DefaultIndex),
+			NextId:        uint64(DefaultIndex),
+			FifoHeadIndex: NoFifoIndex,
+			FifoTailIndex: NoFifoIndex,
 		},
 		StoredGameList: []StoredGame{},
+		PlayerInfoList: []PlayerInfo{},
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
@@ -32,6 +35,16 @@ func (gs GenesisState) Validate() error {
 		}
 		storedGameIndexMap[index] = struct{}{}
 	}
+	// Check for duplicated index in playerInfo
+	playerInfoIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.PlayerInfoList {
+		index := string(PlayerInfoKey(elem.Index))
+		if _, ok := playerInfoIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for playerInfo")
+		}
+		playerInfoIndexMap[index] = struct{}{}
+	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
@@ -28,6 +28,7 @@ type GenesisState struct {
 	Params         Params       `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	SystemInfo     SystemInfo   `protobuf:"bytes,2,opt,name=systemInfo,proto3" json:"systemInfo"`
 	StoredGameList []StoredGame `protobuf:"bytes,3,rep,name=storedGameList,proto3" json:"storedGameList"`
+	PlayerInfoList []PlayerInfo `protobuf:"bytes,4,rep,name=playerInfoList,proto3" json:"playerInfoList"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -84,6 +85,13 @@ func (m *GenesisState) GetStoredGameList() []StoredGame {
 	return nil
 }
 
+func (m *GenesisState) GetPlayerInfoList() []PlayerInfo {
+	if m != nil {
+		return m.PlayerInfoList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "bekauz.checkers.checkers.GenesisState")
 }
@@ -91,24 +99,26 @@ func init() {
 func init() { proto.RegisterFile("checkers/checkers/genesis.proto", fileDescriptor_e29994b75a5b5b77) }
 
 var fileDescriptor_e29994b75a5b5b77 = []byte{
-	// 270 bytes of a gzipped FileDescriptorProto
+	// 295 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0xce, 0x48, 0x4d,
 	0xce, 0x4e, 0x2d, 0x2a, 0xd6, 0x87, 0x33, 0xd2, 0x53, 0xf3, 0x52, 0x8b, 0x33, 0x8b, 0xf5, 0x0a,
 	0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x24, 0x92, 0x52, 0xb3, 0x13, 0x4b, 0xab, 0xf4, 0x60, 0xd2, 0x70,
 	0x86, 0x94, 0x48, 0x7a, 0x7e, 0x7a, 0x3e, 0x58, 0x91, 0x3e, 0x88, 0x05, 0x51, 0x2f, 0x25, 0x87,
 	0x69, 0x60, 0x41, 0x62, 0x51, 0x62, 0x2e, 0xd4, 0x3c, 0x29, 0x65, 0x4c, 0xf9, 0xe2, 0xca, 0xe2,
 	0x92, 0xd4, 0xdc, 0xf8, 0xcc, 0xbc, 0xb4, 0x7c, 0x3c, 0x8a, 0x4a, 0xf2, 0x8b, 0x52, 0x53, 0xe2,
-	0xd3, 0x13, 0x73, 0x53, 0x21, 0x8a, 0x94, 0xde, 0x31, 0x72, 0xf1, 0xb8, 0x43, 0xdc, 0x1a, 0x5c,
-	0x92, 0x58, 0x92, 0x2a, 0x64, 0xc7, 0xc5, 0x06, 0xb1, 0x4a, 0x82, 0x51, 0x81, 0x51, 0x83, 0xdb,
-	0x48, 0x41, 0x0f, 0x97, 0xdb, 0xf5, 0x02, 0xc0, 0xea, 0x9c, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08,
-	0x82, 0xea, 0x12, 0xf2, 0xe2, 0xe2, 0x82, 0x38, 0xc5, 0x33, 0x2f, 0x2d, 0x5f, 0x82, 0x09, 0x6c,
-	0x86, 0x0a, 0x6e, 0x33, 0x82, 0xe1, 0x6a, 0xa1, 0xe6, 0x20, 0xe9, 0x16, 0x0a, 0xe2, 0xe2, 0x83,
-	0xb8, 0xd8, 0x3d, 0x31, 0x37, 0xd5, 0x27, 0xb3, 0xb8, 0x44, 0x82, 0x59, 0x81, 0x99, 0x80, 0x79,
-	0x70, 0xf5, 0x50, 0xf3, 0xd0, 0x4c, 0x70, 0x72, 0x3d, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39,
-	0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63,
-	0x39, 0x86, 0x28, 0xed, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0x88,
-	0xf9, 0x88, 0x80, 0xab, 0x40, 0x30, 0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0xc1, 0x67,
-	0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x4a, 0x49, 0x5d, 0x61, 0xfb, 0x01, 0x00, 0x00,
+	0xd3, 0x13, 0x73, 0x53, 0x71, 0x2b, 0x2a, 0xc8, 0x49, 0xac, 0x4c, 0x2d, 0x42, 0x32, 0x49, 0xe9,
+	0x10, 0x13, 0x17, 0x8f, 0x3b, 0xc4, 0x43, 0xc1, 0x25, 0x89, 0x25, 0xa9, 0x42, 0x76, 0x5c, 0x6c,
+	0x10, 0xf7, 0x48, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x1b, 0x29, 0xe8, 0xe1, 0xf2, 0xa0, 0x5e, 0x00,
+	0x58, 0x9d, 0x13, 0xcb, 0x89, 0x7b, 0xf2, 0x0c, 0x41, 0x50, 0x5d, 0x42, 0x5e, 0x5c, 0x5c, 0x10,
+	0xf7, 0x7a, 0xe6, 0xa5, 0xe5, 0x4b, 0x30, 0x81, 0xcd, 0x50, 0xc1, 0x6d, 0x46, 0x30, 0x5c, 0x2d,
+	0xd4, 0x1c, 0x24, 0xdd, 0x42, 0x41, 0x5c, 0x7c, 0x10, 0x6f, 0xb9, 0x27, 0xe6, 0xa6, 0xfa, 0x64,
+	0x16, 0x97, 0x48, 0x30, 0x2b, 0x30, 0x13, 0x30, 0x0f, 0xae, 0x1e, 0x6a, 0x1e, 0x9a, 0x09, 0x20,
+	0x33, 0x21, 0xa1, 0x00, 0xb2, 0x01, 0x6c, 0x26, 0x0b, 0x21, 0x33, 0x03, 0xe0, 0xea, 0x61, 0x66,
+	0xa2, 0x9a, 0xe0, 0xe4, 0x7a, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9,
+	0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0xda,
+	0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x10, 0xf3, 0x11, 0x91, 0x51,
+	0x81, 0x60, 0x96, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0xa3, 0xc4, 0x18, 0x30, 0x00, 0x8b,
+	0x8d, 0xf8, 0xac, 0x74, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -131,6 +141,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PlayerInfoList) > 0 {
+		for iNdEx := len(m.PlayerInfoList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PlayerInfoList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.StoredGameList) > 0 {
 		for iNdEx := len(m.StoredGameList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -195,6 +219,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PlayerInfoList) > 0 {
+		for _, e := range m.PlayerInfoList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -333,6 +363,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlayerInfoList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlayerInfoList = append(m.PlayerInfoList, PlayerInfo{})
+			if err := m.PlayerInfoList[len(m.PlayerInfoList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
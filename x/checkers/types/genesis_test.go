@@ -24,7 +24,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			desc: "valid genesis state",
 			genState: &types.GenesisState{
-
+				Params: types.DefaultParams(),
 				SystemInfo: types.SystemInfo{
 					NextId: 41,
 				},
@@ -36,6 +36,14 @@ func TestGenesisState_Validate(t *testing.T) {
 						Index: "1",
 					},
 				},
+				PlayerInfoList: []types.PlayerInfo{
+					{
+						Index: "0",
+					},
+					{
+						Index: "1",
+					},
+				},
 				// this line is used by starport scaffolding # types/genesis/validField
 			},
 			valid: true,
@@ -54,6 +62,20 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "duplicated playerInfo",
+			genState: &types.GenesisState{
+				PlayerInfoList: []types.PlayerInfo{
+					{
+						Index: "0",
+					},
+					{
+						Index: "0",
+					},
+				},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		// each of the tests described above are ran through this runner
@@ -72,8 +94,14 @@ func TestGenesisState_Validate(t *testing.T) {
 func TestDefaultGenesisState_ExpectedInitialNextId(t *testing.T) {
 	require.EqualValues(t,
 		&types.GenesisState{
+			Params:         types.DefaultParams(),
 			StoredGameList: []types.StoredGame{},
-			SystemInfo:     types.SystemInfo{uint64(1)},
+			PlayerInfoList: []types.PlayerInfo{},
+			SystemInfo: types.SystemInfo{
+				NextId:        uint64(1),
+				FifoHeadIndex: types.NoFifoIndex,
+				FifoTailIndex: types.NoFifoIndex,
+			},
 		},
 		types.DefaultGenesis())
 }
@@ -28,6 +28,9 @@ const (
 	GameCreatedEventGameIndex = "game-index"       // What game is relevant
 	GameCreatedEventBlack     = "black"            // Is it relevant to me?
 	GameCreatedEventRed       = "red"              // Is it relevant to me?
+	GameCreatedEventWager     = "wager"            // How much is at stake?
+	GameCreatedEventDenom     = "denom"            // In which token?
+	GameCreatedEventVariant   = "variant"          // Which rules?
 )
 
 const (
@@ -38,3 +41,66 @@ const (
 	MovePlayedEventCapturedY = "captured-y"
 	MovePlayedEventWinner    = "winner"
 )
+
+const (
+	MovesPlayedEventType      = "moves-played"
+	MovesPlayedEventCreator   = "creator"
+	MovesPlayedEventGameIndex = "game-index"
+	MovesPlayedEventCapturedX = "captured-x" // Repeated once per capture
+	MovesPlayedEventCapturedY = "captured-y" // Repeated once per capture
+	MovesPlayedEventWinner    = "winner"
+)
+
+const (
+	GameOverEventType      = "game-over"
+	GameOverEventGameIndex = "game-index"
+	GameOverEventWinner    = "winner"
+	GameOverEventBoard     = "board"
+)
+
+const (
+	GameForfeitedEventType      = "game-forfeited"
+	GameForfeitedEventGameIndex = "game-index"
+	GameForfeitedEventWinner    = "winner"
+	GameForfeitedEventBoard     = "board"
+)
+
+const (
+	GameResignedEventType      = "game-resigned"
+	GameResignedEventCreator   = "creator"
+	GameResignedEventGameIndex = "game-index"
+	GameResignedEventWinner    = "winner"
+	GameResignedEventBoard     = "board"
+)
+
+const (
+	DrawOfferedEventType      = "draw-offered"
+	DrawOfferedEventCreator   = "creator"
+	DrawOfferedEventGameIndex = "game-index"
+)
+
+const (
+	DrawAcceptedEventType      = "draw-accepted"
+	DrawAcceptedEventCreator   = "creator"
+	DrawAcceptedEventGameIndex = "game-index"
+	DrawAcceptedEventBoard     = "board"
+)
+
+const (
+	DrawDeclinedEventType      = "draw-declined"
+	DrawDeclinedEventCreator   = "creator"
+	DrawDeclinedEventGameIndex = "game-index"
+)
+
+const (
+	NoFifoIndex = "-1"
+)
+
+const (
+	DeadlineLayout = "2006-01-02 15:04:05.999999999 +0000 UTC"
+)
+
+const (
+	GameStatusActive   = "active"   // Games that have no winner yet
+	GameStatusFinished = "finished" // Games that have a winner or are drawn
+)
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"github.com/bekauz/checkers/x/checkers/rules"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -9,11 +10,15 @@ const TypeMsgCreateGame = "create_game"
 
 var _ sdk.Msg = &MsgCreateGame{}
 
-func NewMsgCreateGame(creator string, black string, red string) *MsgCreateGame {
+func NewMsgCreateGame(creator string, black string, red string, wager uint64, denom string, variant string, board string) *MsgCreateGame {
 	return &MsgCreateGame{
 		Creator: creator,
 		Black:   black,
 		Red:     red,
+		Wager:   wager,
+		Denom:   denom,
+		Variant: variant,
+		Board:   board,
 	}
 }
 
@@ -43,5 +48,21 @@ func (msg *MsgCreateGame) ValidateBasic() error {
 	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
 	}
+	// a game without a wager needs no denom
+	if msg.Wager > 0 {
+		if err := sdk.ValidateDenom(msg.Denom); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidWager, "invalid denom (%s)", err)
+		}
+	}
+	ruleSet, found := rules.GetRuleSet(msg.Variant)
+	if !found {
+		return sdkerrors.Wrapf(ErrInvalidVariant, "%s", msg.Variant)
+	}
+	// the start position is optional, as a FEN or as a board string
+	if msg.Board != "" {
+		if _, err := rules.ParsePosition(ruleSet, msg.Board); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidStartPosition, "%s", err)
+		}
+	}
 	return nil
 }
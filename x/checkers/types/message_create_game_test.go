@@ -25,6 +25,66 @@ func TestMsgCreateGame_ValidateBasic(t *testing.T) {
 			msg: MsgCreateGame{
 				Creator: sample.AccAddress(),
 			},
+		}, {
+			name: "valid wager",
+			msg: MsgCreateGame{
+				Creator: sample.AccAddress(),
+				Wager:   45,
+				Denom:   "stake",
+			},
+		}, {
+			name: "wager without denom",
+			msg: MsgCreateGame{
+				Creator: sample.AccAddress(),
+				Wager:   45,
+			},
+			err: ErrInvalidWager,
+		}, {
+			name: "international variant",
+			msg: MsgCreateGame{
+				Creator: sample.AccAddress(),
+				Variant: "international",
+			},
+		}, {
+			name: "giveaway variant",
+			msg: MsgCreateGame{
+				Creator: sample.AccAddress(),
+				Variant: "giveaway",
+			},
+		}, {
+			name: "start position as FEN",
+			msg: MsgCreateGame{
+				Creator: sample.AccAddress(),
+				Board:   "W:W18,K31:B1,14",
+			},
+		}, {
+			name: "start position as board string",
+			msg: MsgCreateGame{
+				Creator: sample.AccAddress(),
+				Board:   "*b******|********|********|**b*****|***r****|********|********|****R***",
+			},
+		}, {
+			name: "start position of the wrong variant",
+			msg: MsgCreateGame{
+				Creator: sample.AccAddress(),
+				Variant: "international",
+				Board:   "*b******|********|********|**b*****|***r****|********|********|****R***",
+			},
+			err: ErrInvalidStartPosition,
+		}, {
+			name: "invalid FEN",
+			msg: MsgCreateGame{
+				Creator: sample.AccAddress(),
+				Board:   "W:W18,K33:B1",
+			},
+			err: ErrInvalidStartPosition,
+		}, {
+			name: "unknown variant",
+			msg: MsgCreateGame{
+				Creator: sample.AccAddress(),
+				Variant: "chess",
+			},
+			err: ErrInvalidVariant,
 		},
 	}
 	for _, tt := range tests {
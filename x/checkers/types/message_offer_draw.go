@@ -0,0 +1,56 @@
+package types
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgOfferDraw = "offer_draw"
+
+var _ sdk.Msg = &MsgOfferDraw{}
+
+func NewMsgOfferDraw(creator string, gameIndex string) *MsgOfferDraw {
+	return &MsgOfferDraw{
+		Creator:   creator,
+		GameIndex: gameIndex,
+	}
+}
+
+func (msg *MsgOfferDraw) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgOfferDraw) Type() string {
+	return TypeMsgOfferDraw
+}
+
+func (msg *MsgOfferDraw) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgOfferDraw) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgOfferDraw) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	gameIndex, err := strconv.ParseUint(msg.GameIndex, 10, 64)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidGameIndex, "not parseable (%s)", err)
+	}
+	if gameIndex < DefaultIndex {
+		return sdkerrors.Wrapf(ErrInvalidGameIndex, "index too low (%d)", gameIndex)
+	}
+	return nil
+}
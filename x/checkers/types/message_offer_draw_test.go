@@ -0,0 +1,56 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/bekauz/checkers/testutil/sample"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgOfferDraw_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgOfferDraw
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgOfferDraw{
+				Creator:   "invalid_address",
+				GameIndex: "1",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid game index",
+			msg: MsgOfferDraw{
+				Creator:   sample.AccAddress(),
+				GameIndex: "one",
+			},
+			err: ErrInvalidGameIndex,
+		}, {
+			name: "game index too low",
+			msg: MsgOfferDraw{
+				Creator:   sample.AccAddress(),
+				GameIndex: "0",
+			},
+			err: ErrInvalidGameIndex,
+		}, {
+			name: "valid address",
+			msg: MsgOfferDraw{
+				Creator:   sample.AccAddress(),
+				GameIndex: "1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -81,7 +81,7 @@ func (msg *MsgPlayMove) ValidateBasic() error {
 	}
 
 	for _, situation := range boardChecks {
-		if situation.value < 0 || rules.BOARD_DIM <= situation.value {
+		if situation.value < 0 || uint64(rules.MaxBoardDim()) <= situation.value {
 			return sdkerrors.Wrapf(ErrInvalidPositionIndex, situation.err, situation.value)
 		}
 	}
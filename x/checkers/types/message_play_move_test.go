@@ -23,7 +23,12 @@ func TestMsgPlayMove_ValidateBasic(t *testing.T) {
 		}, {
 			name: "valid address",
 			msg: MsgPlayMove{
-				Creator: sample.AccAddress(),
+				Creator:   sample.AccAddress(),
+				GameIndex: "1",
+				FromX:     1,
+				FromY:     2,
+				ToX:       2,
+				ToY:       3,
 			},
 		},
 	}
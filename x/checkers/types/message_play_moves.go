@@ -0,0 +1,71 @@
+package types
+
+import (
+	"strconv"
+
+	"github.com/bekauz/checkers/x/checkers/rules"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgPlayMoves = "play_moves"
+
+var _ sdk.Msg = &MsgPlayMoves{}
+
+func NewMsgPlayMoves(creator string, gameIndex string, path []Position) *MsgPlayMoves {
+	return &MsgPlayMoves{
+		Creator:   creator,
+		GameIndex: gameIndex,
+		Path:      path,
+	}
+}
+
+func (msg *MsgPlayMoves) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgPlayMoves) Type() string {
+	return TypeMsgPlayMoves
+}
+
+func (msg *MsgPlayMoves) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgPlayMoves) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgPlayMoves) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	gameIndex, err := strconv.ParseUint(msg.GameIndex, 10, 64)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidGameIndex, "not parseable (%s)", err)
+	}
+	if gameIndex < DefaultIndex {
+		return sdkerrors.Wrapf(ErrInvalidGameIndex, "index too low (%d)", gameIndex)
+	}
+
+	if len(msg.Path) < 2 {
+		return sdkerrors.Wrapf(ErrMoveAbsent, "path has %d positions", len(msg.Path))
+	}
+	for i, position := range msg.Path {
+		if uint64(rules.MaxBoardDim()) <= position.X || uint64(rules.MaxBoardDim()) <= position.Y {
+			return sdkerrors.Wrapf(ErrInvalidPositionIndex, "position %d out of bounds (%d, %d)", i, position.X, position.Y)
+		}
+		if 0 < i && position == msg.Path[i-1] {
+			return sdkerrors.Wrapf(ErrMoveAbsent, "position %d repeats (%d, %d)", i, position.X, position.Y)
+		}
+	}
+
+	return nil
+}
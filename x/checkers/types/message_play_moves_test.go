@@ -0,0 +1,76 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/bekauz/checkers/testutil/sample"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgPlayMoves_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgPlayMoves
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgPlayMoves{
+				Creator:   "invalid_address",
+				GameIndex: "1",
+				Path:      []Position{{X: 1, Y: 2}, {X: 2, Y: 3}},
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid game index",
+			msg: MsgPlayMoves{
+				Creator:   sample.AccAddress(),
+				GameIndex: "one",
+				Path:      []Position{{X: 1, Y: 2}, {X: 2, Y: 3}},
+			},
+			err: ErrInvalidGameIndex,
+		}, {
+			name: "path too short",
+			msg: MsgPlayMoves{
+				Creator:   sample.AccAddress(),
+				GameIndex: "1",
+				Path:      []Position{{X: 1, Y: 2}},
+			},
+			err: ErrMoveAbsent,
+		}, {
+			name: "position out of bounds",
+			msg: MsgPlayMoves{
+				Creator:   sample.AccAddress(),
+				GameIndex: "1",
+				Path:      []Position{{X: 1, Y: 2}, {X: 3, Y: 4}, {X: 5, Y: 10}},
+			},
+			err: ErrInvalidPositionIndex,
+		}, {
+			name: "repeated position",
+			msg: MsgPlayMoves{
+				Creator:   sample.AccAddress(),
+				GameIndex: "1",
+				Path:      []Position{{X: 1, Y: 2}, {X: 1, Y: 2}},
+			},
+			err: ErrMoveAbsent,
+		}, {
+			name: "valid path",
+			msg: MsgPlayMoves{
+				Creator:   sample.AccAddress(),
+				GameIndex: "1",
+				Path:      []Position{{X: 1, Y: 2}, {X: 3, Y: 4}, {X: 5, Y: 6}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/bekauz/checkers/testutil/sample"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgResign_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgResign
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgResign{
+				Creator:   "invalid_address",
+				GameIndex: "1",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid game index",
+			msg: MsgResign{
+				Creator:   sample.AccAddress(),
+				GameIndex: "one",
+			},
+			err: ErrInvalidGameIndex,
+		}, {
+			name: "game index too low",
+			msg: MsgResign{
+				Creator:   sample.AccAddress(),
+				GameIndex: "0",
+			},
+			err: ErrInvalidGameIndex,
+		}, {
+			name: "valid address",
+			msg: MsgResign{
+				Creator:   sample.AccAddress(),
+				GameIndex: "1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -1,35 +1,102 @@
 package types
 
 import (
+	"fmt"
+	"time"
+
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"gopkg.in/yaml.v2"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
+var (
+	KeyMaxTurnDuration = []byte("MaxTurnDuration")
+	// DefaultMaxTurnDuration gives a player a full day to make a move
+	DefaultMaxTurnDuration = 24 * time.Hour
+)
+
+var (
+	KeyEloKFactor            = []byte("EloKFactor")
+	DefaultEloKFactor uint64 = 32
+)
+
+var (
+	KeyEloStartingRating            = []byte("EloStartingRating")
+	DefaultEloStartingRating uint64 = 1200
+)
+
+var (
+	KeyDrawRepetitionLimit = []byte("DrawRepetitionLimit")
+	// DefaultDrawRepetitionLimit is the threefold repetition rule
+	DefaultDrawRepetitionLimit uint64 = 3
+)
+
+var (
+	KeyDrawNoProgressLimit = []byte("DrawNoProgressLimit")
+	// DefaultDrawNoProgressLimit gives each player 40 moves to make progress
+	DefaultDrawNoProgressLimit uint64 = 80
+)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
 // NewParams creates a new Params instance
-func NewParams() Params {
-	return Params{}
+func NewParams(
+	maxTurnDuration time.Duration,
+	eloKFactor uint64,
+	eloStartingRating uint64,
+	drawRepetitionLimit uint64,
+	drawNoProgressLimit uint64,
+) Params {
+	return Params{
+		MaxTurnDuration:     maxTurnDuration,
+		EloKFactor:          eloKFactor,
+		EloStartingRating:   eloStartingRating,
+		DrawRepetitionLimit: drawRepetitionLimit,
+		DrawNoProgressLimit: drawNoProgressLimit,
+	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(
+		DefaultMaxTurnDuration,
+		DefaultEloKFactor,
+		DefaultEloStartingRating,
+		DefaultDrawRepetitionLimit,
+		DefaultDrawNoProgressLimit,
+	)
 }
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyMaxTurnDuration, &p.MaxTurnDuration, validateMaxTurnDuration),
+		paramtypes.NewParamSetPair(KeyEloKFactor, &p.EloKFactor, validateEloKFactor),
+		paramtypes.NewParamSetPair(KeyEloStartingRating, &p.EloStartingRating, validateEloStartingRating),
+		paramtypes.NewParamSetPair(KeyDrawRepetitionLimit, &p.DrawRepetitionLimit, validateDrawRepetitionLimit),
+		paramtypes.NewParamSetPair(KeyDrawNoProgressLimit, &p.DrawNoProgressLimit, validateDrawNoProgressLimit),
+	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
-	return nil
+	if err := validateMaxTurnDuration(p.MaxTurnDuration); err != nil {
+		return err
+	}
+	if err := validateEloKFactor(p.EloKFactor); err != nil {
+		return err
+	}
+	if err := validateEloStartingRating(p.EloStartingRating); err != nil {
+		return err
+	}
+	if err := validateDrawRepetitionLimit(p.DrawRepetitionLimit); err != nil {
+		return err
+	}
+	return validateDrawNoProgressLimit(p.DrawNoProgressLimit)
 }
 
 // String implements the Stringer interface.
@@ -37,3 +104,55 @@ func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
 	return string(out)
 }
+
+func validateMaxTurnDuration(v interface{}) error {
+	maxTurnDuration, ok := v.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if maxTurnDuration <= 0 {
+		return fmt.Errorf("max turn duration must be positive: %s", maxTurnDuration)
+	}
+	return nil
+}
+
+func validateEloKFactor(v interface{}) error {
+	eloKFactor, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if eloKFactor == 0 {
+		return fmt.Errorf("elo k-factor must be positive: %d", eloKFactor)
+	}
+	return nil
+}
+
+func validateEloStartingRating(v interface{}) error {
+	eloStartingRating, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if eloStartingRating == 0 {
+		return fmt.Errorf("elo starting rating must be positive: %d", eloStartingRating)
+	}
+	return nil
+}
+
+func validateDrawRepetitionLimit(v interface{}) error {
+	drawRepetitionLimit, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if drawRepetitionLimit == 1 {
+		return fmt.Errorf("draw repetition limit must be 0 or at least 2: %d", drawRepetitionLimit)
+	}
+	return nil
+}
+
+func validateDrawNoProgressLimit(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	return nil
+}
@@ -7,15 +7,19 @@ import (
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -25,6 +29,18 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	// maxTurnDuration is how long a player has to play before forfeiting.
+	MaxTurnDuration time.Duration `protobuf:"bytes,1,opt,name=maxTurnDuration,proto3,stdduration" json:"maxTurnDuration" yaml:"max_turn_duration"`
+	// eloKFactor is the largest rating change a single game can cause.
+	EloKFactor uint64 `protobuf:"varint,2,opt,name=eloKFactor,proto3" json:"eloKFactor,omitempty" yaml:"elo_k_factor"`
+	// eloStartingRating is the rating of a player before their first game.
+	EloStartingRating uint64 `protobuf:"varint,3,opt,name=eloStartingRating,proto3" json:"eloStartingRating,omitempty" yaml:"elo_starting_rating"`
+	// drawRepetitionLimit is how many times the same position must occur for
+	// the game to be drawn. Zero disables the rule.
+	DrawRepetitionLimit uint64 `protobuf:"varint,4,opt,name=drawRepetitionLimit,proto3" json:"drawRepetitionLimit,omitempty" yaml:"draw_repetition_limit"`
+	// drawNoProgressLimit is how many moves without a capture or a man advance
+	// draw the game. Zero disables the rule.
+	DrawNoProgressLimit uint64 `protobuf:"varint,5,opt,name=drawNoProgressLimit,proto3" json:"drawNoProgressLimit,omitempty" yaml:"draw_no_progress_limit"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -59,6 +75,41 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetMaxTurnDuration() time.Duration {
+	if m != nil {
+		return m.MaxTurnDuration
+	}
+	return 0
+}
+
+func (m *Params) GetEloKFactor() uint64 {
+	if m != nil {
+		return m.EloKFactor
+	}
+	return 0
+}
+
+func (m *Params) GetEloStartingRating() uint64 {
+	if m != nil {
+		return m.EloStartingRating
+	}
+	return 0
+}
+
+func (m *Params) GetDrawRepetitionLimit() uint64 {
+	if m != nil {
+		return m.DrawRepetitionLimit
+	}
+	return 0
+}
+
+func (m *Params) GetDrawNoProgressLimit() uint64 {
+	if m != nil {
+		return m.DrawNoProgressLimit
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "bekauz.checkers.checkers.Params")
 }
@@ -66,17 +117,32 @@ func init() {
 func init() { proto.RegisterFile("checkers/checkers/params.proto", fileDescriptor_041657f11902477b) }
 
 var fileDescriptor_041657f11902477b = []byte{
-	// 145 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xce, 0x48, 0x4d,
-	0xce, 0x4e, 0x2d, 0x2a, 0xd6, 0x87, 0x33, 0x0a, 0x12, 0x8b, 0x12, 0x73, 0x8b, 0xf5, 0x0a, 0x8a,
-	0xf2, 0x4b, 0xf2, 0x85, 0x24, 0x92, 0x52, 0xb3, 0x13, 0x4b, 0xab, 0xf4, 0x60, 0xb2, 0x70, 0x86,
-	0x94, 0x48, 0x7a, 0x7e, 0x7a, 0x3e, 0x58, 0x91, 0x3e, 0x88, 0x05, 0x51, 0xaf, 0xc4, 0xc7, 0xc5,
-	0x16, 0x00, 0xd6, 0x6f, 0xc5, 0x32, 0x63, 0x81, 0x3c, 0x83, 0x93, 0xeb, 0x89, 0x47, 0x72, 0x8c,
-	0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72,
-	0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x69, 0xa7, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7,
-	0xe7, 0xea, 0x43, 0x2c, 0x41, 0x38, 0xa1, 0x02, 0xc1, 0x2c, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62,
-	0x03, 0x9b, 0x6e, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x7e, 0x58, 0x6f, 0xf2, 0xaf, 0x00, 0x00,
-	0x00,
+	// 392 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xb1, 0x6e, 0xda, 0x40,
+	0x18, 0xc7, 0xed, 0x42, 0x19, 0xdc, 0xa1, 0xaa, 0xa9, 0x54, 0x17, 0xb5, 0x77, 0xd4, 0xea, 0x80,
+	0x54, 0xc9, 0x96, 0x9a, 0x21, 0x12, 0x23, 0x4a, 0xb2, 0x04, 0x45, 0xc8, 0x64, 0xca, 0x72, 0x3a,
+	0x9b, 0xc3, 0x58, 0xd8, 0x3e, 0xeb, 0x7c, 0x56, 0x20, 0x4f, 0x91, 0x91, 0x31, 0x6b, 0xde, 0x84,
+	0x91, 0x31, 0x93, 0x13, 0xc1, 0x1b, 0xf8, 0x09, 0x22, 0xdf, 0x61, 0x20, 0x24, 0x8b, 0xf5, 0xb7,
+	0xbe, 0xdf, 0xff, 0xf7, 0x59, 0xf2, 0xa7, 0x01, 0x6f, 0x42, 0xbc, 0x29, 0x61, 0xa9, 0xbd, 0x0b,
+	0x09, 0x66, 0x38, 0x4a, 0xad, 0x84, 0x51, 0x4e, 0x75, 0xc3, 0x25, 0x53, 0x9c, 0xdd, 0x59, 0xd5,
+	0x74, 0x17, 0x5a, 0xdf, 0x7d, 0xea, 0x53, 0x01, 0xd9, 0x65, 0x92, 0x7c, 0x0b, 0xf8, 0x94, 0xfa,
+	0x21, 0xb1, 0xc5, 0x9b, 0x9b, 0x8d, 0xed, 0x51, 0xc6, 0x30, 0x0f, 0x68, 0x2c, 0xe7, 0xe6, 0x63,
+	0x4d, 0x6b, 0x0c, 0xc4, 0x02, 0x3d, 0xd0, 0xbe, 0x46, 0x78, 0x76, 0x9d, 0xb1, 0xf8, 0x6c, 0xcb,
+	0x18, 0x6a, 0x5b, 0xed, 0x7c, 0xf9, 0xff, 0xd3, 0x92, 0x12, 0xab, 0x92, 0x58, 0x15, 0xd0, 0xfb,
+	0xbb, 0xcc, 0xa1, 0x52, 0xe4, 0xd0, 0x98, 0xe3, 0x28, 0xec, 0x9a, 0x11, 0x9e, 0x21, 0x9e, 0xb1,
+	0x18, 0x55, 0x5b, 0xcc, 0xc5, 0x33, 0x54, 0x9d, 0x63, 0xaf, 0x7e, 0xaa, 0x69, 0x24, 0xa4, 0x97,
+	0x17, 0xd8, 0xe3, 0x94, 0x19, 0x9f, 0xda, 0x6a, 0xa7, 0xde, 0xfb, 0x51, 0xe4, 0xb0, 0x29, 0x35,
+	0x24, 0xa4, 0x68, 0x8a, 0xc6, 0x62, 0x6a, 0x3a, 0x07, 0xa8, 0xde, 0xd7, 0xbe, 0x91, 0x90, 0x0e,
+	0x39, 0x66, 0x3c, 0x88, 0x7d, 0x07, 0x97, 0x4f, 0xa3, 0x26, 0xfa, 0xa0, 0xc8, 0x61, 0x6b, 0xdf,
+	0x4f, 0xb7, 0x0c, 0x62, 0x02, 0x32, 0x9d, 0xf7, 0x45, 0xdd, 0xd1, 0x9a, 0x23, 0x86, 0x6f, 0x1d,
+	0x92, 0x10, 0x1e, 0x94, 0x1f, 0xd6, 0x0f, 0xa2, 0x80, 0x1b, 0x75, 0xe1, 0x6b, 0x17, 0x39, 0xfc,
+	0x25, 0x7d, 0x25, 0x84, 0xd8, 0x8e, 0x42, 0x61, 0x89, 0x99, 0xce, 0x47, 0x65, 0x7d, 0x28, 0x9d,
+	0x57, 0x74, 0xc0, 0xa8, 0xcf, 0x48, 0x9a, 0x4a, 0xe7, 0x67, 0xe1, 0xfc, 0x53, 0xe4, 0xf0, 0xf7,
+	0x81, 0x33, 0xa6, 0x28, 0xd9, 0x62, 0x6f, 0xa4, 0x47, 0xed, 0x6e, 0x7d, 0xf1, 0x00, 0x95, 0xde,
+	0xf9, 0x72, 0x0d, 0xd4, 0xd5, 0x1a, 0xa8, 0x2f, 0x6b, 0xa0, 0xde, 0x6f, 0x80, 0xb2, 0xda, 0x00,
+	0xe5, 0x69, 0x03, 0x94, 0x9b, 0x7f, 0x7e, 0xc0, 0x27, 0x99, 0x6b, 0x79, 0x34, 0xb2, 0xe5, 0x81,
+	0xec, 0xcf, 0x67, 0xb6, 0x8f, 0x7c, 0x9e, 0x90, 0xd4, 0x6d, 0x88, 0xdf, 0x78, 0xf2, 0x3a, 0x00,
+	0xbd, 0x12, 0xbf, 0x3b, 0x6b, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -99,6 +165,34 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DrawNoProgressLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DrawNoProgressLimit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.DrawRepetitionLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DrawRepetitionLimit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EloStartingRating != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.EloStartingRating))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EloKFactor != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.EloKFactor))
+		i--
+		dAtA[i] = 0x10
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxTurnDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxTurnDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
@@ -119,6 +213,20 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxTurnDuration)
+	n += 1 + l + sovParams(uint64(l))
+	if m.EloKFactor != 0 {
+		n += 1 + sovParams(uint64(m.EloKFactor))
+	}
+	if m.EloStartingRating != 0 {
+		n += 1 + sovParams(uint64(m.EloStartingRating))
+	}
+	if m.DrawRepetitionLimit != 0 {
+		n += 1 + sovParams(uint64(m.DrawRepetitionLimit))
+	}
+	if m.DrawNoProgressLimit != 0 {
+		n += 1 + sovParams(uint64(m.DrawNoProgressLimit))
+	}
 	return n
 }
 
@@ -157,6 +265,115 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTurnDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxTurnDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EloKFactor", wireType)
+			}
+			m.EloKFactor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EloKFactor |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EloStartingRating", wireType)
+			}
+			m.EloStartingRating = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EloStartingRating |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrawRepetitionLimit", wireType)
+			}
+			m.DrawRepetitionLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DrawRepetitionLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrawNoProgressLimit", wireType)
+			}
+			m.DrawNoProgressLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DrawNoProgressLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,111 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/bekauz/checkers/x/checkers/rules"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Each turn is recorded in Moves as the coordinates visited by the moving
+// piece, such as "1,2-2,3" for a move or "1,2x3,4x5,6" for captures.
+const (
+	MoveSep    = "-"
+	CaptureSep = "x"
+
+	PdnTagGameIndex = "GameIndex"
+)
+
+// RecordMove adds a played path to the move list. A path that starts where
+// the previous one ended continues the same turn.
+func (storedGame *StoredGame) RecordMove(path []rules.Pos, capture bool) {
+	separator := MoveSep
+	if capture {
+		separator = CaptureSep
+	}
+	squares := make([]string, 0, len(path))
+	for _, pos := range path {
+		squares = append(squares, fmt.Sprintf("%d,%d", pos.X, pos.Y))
+	}
+	if last := len(storedGame.Moves) - 1; 0 <= last && capture &&
+		strings.HasSuffix(storedGame.Moves[last], CaptureSep+squares[0]) {
+		// only the piece that just captured can still be on the square it
+		// landed on when its player is to move again
+		storedGame.Moves[last] += CaptureSep + strings.Join(squares[1:], CaptureSep)
+		return
+	}
+	storedGame.Moves = append(storedGame.Moves, strings.Join(squares, separator))
+}
+
+func (storedGame StoredGame) ParseMoves() (moves []rules.PdnMove, err error) {
+	moves = make([]rules.PdnMove, 0, len(storedGame.Moves))
+	for _, recorded := range storedGame.Moves {
+		move := rules.PdnMove{Capture: strings.Contains(recorded, CaptureSep)}
+		separator := MoveSep
+		if move.Capture {
+			separator = CaptureSep
+		}
+		for _, square := range strings.Split(recorded, separator) {
+			coordinates := strings.Split(square, ",")
+			if len(coordinates) != 2 {
+				return nil, sdkerrors.Wrapf(ErrGameNotParseable, "Move: %s", recorded)
+			}
+			x, errX := strconv.Atoi(coordinates[0])
+			y, errY := strconv.Atoi(coordinates[1])
+			if errX != nil || errY != nil {
+				return nil, sdkerrors.Wrapf(ErrGameNotParseable, "Move: %s", recorded)
+			}
+			move.Path = append(move.Path, rules.Pos{X: x, Y: y})
+		}
+		if len(move.Path) < 2 {
+			return nil, sdkerrors.Wrapf(ErrGameNotParseable, "Move: %s", recorded)
+		}
+		moves = append(moves, move)
+	}
+	return moves, nil
+}
+
+// ExportPdn writes the game and its moves in Portable Draughts Notation, red
+// being called white unless the variant has NotationWhiteFirst.
+func (storedGame StoredGame) ExportPdn() (pdn *rules.Pdn, err error) {
+	ruleSet, err := storedGame.GetRuleSet()
+	if err != nil {
+		return nil, err
+	}
+	moves, err := storedGame.ParseMoves()
+	if err != nil {
+		return nil, err
+	}
+	result := rules.PDN_ONGOING
+	for player, pdnResult := range rules.PdnResults {
+		if storedGame.Winner == rules.PieceStrings[player] {
+			result = pdnResult
+		}
+	}
+	black, white := storedGame.Black, storedGame.Red
+	if ruleSet.NotationWhiteFirst {
+		black, white = white, black
+	}
+	tags := []rules.PdnTag{
+		{Name: rules.PDN_TAG_EVENT, Value: fmt.Sprintf("%s game %s", ModuleName, storedGame.Index)},
+		{Name: PdnTagGameIndex, Value: storedGame.Index},
+		{Name: rules.PDN_TAG_BLACK, Value: black},
+		{Name: rules.PDN_TAG_WHITE, Value: white},
+		{Name: rules.PDN_TAG_RESULT, Value: result},
+	}
+	if ruleSet.PdnGameType != 0 {
+		tags = append(tags, rules.PdnTag{Name: rules.PDN_TAG_GAME_TYPE, Value: strconv.Itoa(ruleSet.PdnGameType)})
+	}
+	tags = append(tags, rules.PdnTag{Name: rules.PDN_TAG_VARIANT, Value: ruleSet.Name})
+	if storedGame.StartPosition != "" {
+		tags = append(tags, rules.PdnTag{Name: rules.PDN_TAG_FEN, Value: storedGame.StartPosition})
+	}
+	return &rules.Pdn{
+		Tags:    tags,
+		RuleSet: ruleSet,
+		Moves:   moves,
+		Result:  result,
+	}, nil
+}
@@ -0,0 +1,94 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/bekauz/checkers/x/checkers/rules"
+	"github.com/bekauz/checkers/x/checkers/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordMoveJoinsHopsOfOneTurn(t *testing.T) {
+	storedGame := GetStoredGame1()
+	storedGame.RecordMove([]rules.Pos{{X: 5, Y: 2}, {X: 4, Y: 3}}, false)
+	storedGame.RecordMove([]rules.Pos{{X: 2, Y: 5}, {X: 3, Y: 4}}, false)
+	storedGame.RecordMove([]rules.Pos{{X: 4, Y: 3}, {X: 2, Y: 5}}, true)
+	storedGame.RecordMove([]rules.Pos{{X: 2, Y: 5}, {X: 4, Y: 7}}, true)
+	storedGame.RecordMove([]rules.Pos{{X: 5, Y: 6}, {X: 3, Y: 4}}, true)
+
+	require.Equal(t, []string{"5,2-4,3", "2,5-3,4", "4,3x2,5x4,7", "5,6x3,4"}, storedGame.Moves)
+	moves, err := storedGame.ParseMoves()
+	require.Nil(t, err)
+	require.Equal(t, rules.PdnMove{Path: []rules.Pos{{X: 4, Y: 3}, {X: 2, Y: 5}, {X: 4, Y: 7}}, Capture: true}, moves[2])
+}
+
+func TestParseMovesWrong(t *testing.T) {
+	storedGame := GetStoredGame1()
+	storedGame.Moves = []string{"5,2-4"}
+	_, err := storedGame.ParseMoves()
+	require.ErrorIs(t, err, types.ErrGameNotParseable)
+	require.ErrorIs(t, storedGame.Validate(), types.ErrGameNotParseable)
+}
+
+func TestExportPdn(t *testing.T) {
+	storedGame := GetStoredGame1()
+	storedGame.Winner = "r"
+	storedGame.Moves = []string{"5,2-4,3", "4,5-5,4", "6,1-5,2", "2,5-3,4", "4,3x2,5", "1,6x3,4"}
+
+	pdn, err := storedGame.ExportPdn()
+	require.Nil(t, err)
+	require.Equal(t, `[Event "checkers game 1"]
+[GameIndex "1"]
+[Black "`+alice+`"]
+[White "`+bob+`"]
+[Result "0-1"]
+[GameType "21"]
+[Variant "english"]
+
+1. 11-15 23-19 2. 8-11 22-18 3. 15x22 25x18 0-1
+`, pdn.String())
+
+	imported, err := rules.ParsePdn(pdn.String())
+	require.Nil(t, err)
+	_, err = imported.Replay()
+	require.Nil(t, err)
+}
+
+func TestExportPdnDraw(t *testing.T) {
+	storedGame := GetStoredGame1()
+	storedGame.Winner = "d"
+	storedGame.Variant = rules.INTERNATIONAL
+
+	pdn, err := storedGame.ExportPdn()
+	require.Nil(t, err)
+	require.Equal(t, rules.PDN_DRAW, pdn.Tag(rules.PDN_TAG_RESULT))
+	require.Equal(t, "20", pdn.Tag(rules.PDN_TAG_GAME_TYPE))
+	require.Same(t, rules.International, pdn.RuleSet)
+}
+
+func TestExportPdnInternationalHasWhiteMovingFirst(t *testing.T) {
+	storedGame := GetStoredGame1()
+	storedGame.Variant = rules.INTERNATIONAL
+	storedGame.Board = rules.NewGame(rules.International).String()
+	storedGame.Moves = []string{"6,3-5,4", "3,6-4,5"}
+
+	pdn, err := storedGame.ExportPdn()
+	require.Nil(t, err)
+	require.Equal(t, `[Event "checkers game 1"]
+[GameIndex "1"]
+[Black "`+bob+`"]
+[White "`+alice+`"]
+[Result "*"]
+[GameType "20"]
+[Variant "international"]
+
+1. 32-28 19-23 *
+`, pdn.String())
+
+	imported, err := rules.ParsePdn(pdn.String())
+	require.Nil(t, err)
+	game, err := imported.Replay()
+	require.Nil(t, err)
+	require.Equal(t, rules.BLACK_PLAYER, game.Turn)
+	require.Equal(t, "W:W28,31,33,34,35,36,37,38,39,40,41,42,43,44,45,46,47,48,49,50:B1,2,3,4,5,6,7,8,9,10,11,12,13,14,15,16,17,18,20,23", game.Fen())
+}
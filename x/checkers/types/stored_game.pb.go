@@ -23,12 +23,25 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type StoredGame struct {
-	Index  string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
-	Board  string `protobuf:"bytes,2,opt,name=board,proto3" json:"board,omitempty"`
-	Turn   string `protobuf:"bytes,3,opt,name=turn,proto3" json:"turn,omitempty"`
-	Black  string `protobuf:"bytes,4,opt,name=black,proto3" json:"black,omitempty"`
-	Red    string `protobuf:"bytes,5,opt,name=red,proto3" json:"red,omitempty"`
-	Winner string `protobuf:"bytes,6,opt,name=winner,proto3" json:"winner,omitempty"`
+	Index         string   `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	Board         string   `protobuf:"bytes,2,opt,name=board,proto3" json:"board,omitempty"`
+	Turn          string   `protobuf:"bytes,3,opt,name=turn,proto3" json:"turn,omitempty"`
+	Black         string   `protobuf:"bytes,4,opt,name=black,proto3" json:"black,omitempty"`
+	Red           string   `protobuf:"bytes,5,opt,name=red,proto3" json:"red,omitempty"`
+	Winner        string   `protobuf:"bytes,6,opt,name=winner,proto3" json:"winner,omitempty"`
+	Deadline      string   `protobuf:"bytes,7,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	MoveCount     uint64   `protobuf:"varint,8,opt,name=moveCount,proto3" json:"moveCount,omitempty"`
+	BeforeIndex   string   `protobuf:"bytes,9,opt,name=beforeIndex,proto3" json:"beforeIndex,omitempty"`
+	AfterIndex    string   `protobuf:"bytes,10,opt,name=afterIndex,proto3" json:"afterIndex,omitempty"`
+	Wager         uint64   `protobuf:"varint,11,opt,name=wager,proto3" json:"wager,omitempty"`
+	Denom         string   `protobuf:"bytes,12,opt,name=denom,proto3" json:"denom,omitempty"`
+	DrawOffer     string   `protobuf:"bytes,13,opt,name=drawOffer,proto3" json:"drawOffer,omitempty"`
+	History       []string `protobuf:"bytes,14,rep,name=history,proto3" json:"history,omitempty"`
+	Variant       string   `protobuf:"bytes,15,opt,name=variant,proto3" json:"variant,omitempty"`
+	Moves         []string `protobuf:"bytes,16,rep,name=moves,proto3" json:"moves,omitempty"`
+	StartPosition string   `protobuf:"bytes,17,opt,name=startPosition,proto3" json:"startPosition,omitempty"`
+	BlackPaid     bool     `protobuf:"varint,18,opt,name=blackPaid,proto3" json:"blackPaid,omitempty"`
+	RedPaid       bool     `protobuf:"varint,19,opt,name=redPaid,proto3" json:"redPaid,omitempty"`
 }
 
 func (m *StoredGame) Reset()         { *m = StoredGame{} }
@@ -106,6 +119,97 @@ func (m *StoredGame) GetWinner() string {
 	return ""
 }
 
+func (m *StoredGame) GetDeadline() string {
+	if m != nil {
+		return m.Deadline
+	}
+	return ""
+}
+
+func (m *StoredGame) GetMoveCount() uint64 {
+	if m != nil {
+		return m.MoveCount
+	}
+	return 0
+}
+
+func (m *StoredGame) GetBeforeIndex() string {
+	if m != nil {
+		return m.BeforeIndex
+	}
+	return ""
+}
+
+func (m *StoredGame) GetAfterIndex() string {
+	if m != nil {
+		return m.AfterIndex
+	}
+	return ""
+}
+
+func (m *StoredGame) GetWager() uint64 {
+	if m != nil {
+		return m.Wager
+	}
+	return 0
+}
+
+func (m *StoredGame) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *StoredGame) GetDrawOffer() string {
+	if m != nil {
+		return m.DrawOffer
+	}
+	return ""
+}
+
+func (m *StoredGame) GetHistory() []string {
+	if m != nil {
+		return m.History
+	}
+	return nil
+}
+
+func (m *StoredGame) GetVariant() string {
+	if m != nil {
+		return m.Variant
+	}
+	return ""
+}
+
+func (m *StoredGame) GetMoves() []string {
+	if m != nil {
+		return m.Moves
+	}
+	return nil
+}
+
+func (m *StoredGame) GetStartPosition() string {
+	if m != nil {
+		return m.StartPosition
+	}
+	return ""
+}
+
+func (m *StoredGame) GetBlackPaid() bool {
+	if m != nil {
+		return m.BlackPaid
+	}
+	return false
+}
+
+func (m *StoredGame) GetRedPaid() bool {
+	if m != nil {
+		return m.RedPaid
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*StoredGame)(nil), "bekauz.checkers.checkers.StoredGame")
 }
@@ -115,21 +219,32 @@ func init() {
 }
 
 var fileDescriptor_6a777ebb9b26769b = []byte{
-	// 220 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4e, 0xce, 0x48, 0x4d,
-	0xce, 0x4e, 0x2d, 0x2a, 0xd6, 0x87, 0x33, 0x8a, 0x4b, 0xf2, 0x8b, 0x52, 0x53, 0xe2, 0xd3, 0x13,
-	0x73, 0x53, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x24, 0x92, 0x52, 0xb3, 0x13, 0x4b, 0xab,
-	0xf4, 0x60, 0x4a, 0xe0, 0x0c, 0xa5, 0x1e, 0x46, 0x2e, 0xae, 0x60, 0xb0, 0x7a, 0xf7, 0xc4, 0xdc,
-	0x54, 0x21, 0x11, 0x2e, 0xd6, 0xcc, 0xbc, 0x94, 0xd4, 0x0a, 0x09, 0x46, 0x05, 0x46, 0x0d, 0xce,
-	0x20, 0x08, 0x07, 0x24, 0x9a, 0x94, 0x9f, 0x58, 0x94, 0x22, 0xc1, 0x04, 0x11, 0x05, 0x73, 0x84,
-	0x84, 0xb8, 0x58, 0x4a, 0x4a, 0x8b, 0xf2, 0x24, 0x98, 0xc1, 0x82, 0x60, 0x36, 0x58, 0x65, 0x4e,
-	0x62, 0x72, 0xb6, 0x04, 0x0b, 0x54, 0x25, 0x88, 0x23, 0x24, 0xc0, 0xc5, 0x5c, 0x94, 0x9a, 0x22,
-	0xc1, 0x0a, 0x16, 0x03, 0x31, 0x85, 0xc4, 0xb8, 0xd8, 0xca, 0x33, 0xf3, 0xf2, 0x52, 0x8b, 0x24,
-	0xd8, 0xc0, 0x82, 0x50, 0x9e, 0x93, 0xeb, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e,
-	0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31,
-	0x44, 0x69, 0xa7, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x43, 0x7c, 0x83,
-	0xf0, 0x70, 0x05, 0x82, 0x59, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0xf6, 0xb6, 0x31, 0x20,
-	0x00, 0x00, 0xff, 0xff, 0x3d, 0xf2, 0xb3, 0x1e, 0x1d, 0x01, 0x00, 0x00,
+	// 395 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x92, 0xcf, 0x8e, 0xd3, 0x30,
+	0x10, 0x87, 0x1b, 0xd2, 0xed, 0x36, 0x5e, 0x16, 0x16, 0x83, 0xd0, 0x08, 0xa1, 0x28, 0x02, 0x0e,
+	0x91, 0x90, 0xba, 0x07, 0xde, 0x00, 0x84, 0x10, 0x27, 0xaa, 0x70, 0xe3, 0x82, 0x9c, 0x78, 0xd2,
+	0x5a, 0x6d, 0xec, 0xca, 0x71, 0xfa, 0x87, 0xa7, 0xe0, 0xb1, 0x38, 0xf6, 0xc8, 0x11, 0xb5, 0x6f,
+	0xc1, 0x09, 0x79, 0xd2, 0x34, 0xe5, 0x36, 0xdf, 0x37, 0xbf, 0xa9, 0xc7, 0x8d, 0xd9, 0xeb, 0x62,
+	0x8e, 0xc5, 0x02, 0x6d, 0x7d, 0x7f, 0x2e, 0x6a, 0x67, 0x2c, 0xca, 0xef, 0x33, 0x51, 0xe1, 0x64,
+	0x65, 0x8d, 0x33, 0x1c, 0x72, 0x5c, 0x88, 0xe6, 0xc7, 0xa4, 0x8b, 0x9c, 0x8b, 0x57, 0x7f, 0x43,
+	0xc6, 0xbe, 0x52, 0xfe, 0x93, 0xa8, 0x90, 0x3f, 0x63, 0x57, 0x4a, 0x4b, 0xdc, 0x42, 0x90, 0x04,
+	0x69, 0x94, 0xb5, 0xe0, 0x6d, 0x6e, 0x84, 0x95, 0xf0, 0xa0, 0xb5, 0x04, 0x9c, 0xb3, 0xa1, 0x6b,
+	0xac, 0x86, 0x90, 0x24, 0xd5, 0x94, 0x5c, 0x8a, 0x62, 0x01, 0xc3, 0x53, 0xd2, 0x03, 0xbf, 0x63,
+	0xa1, 0x45, 0x09, 0x57, 0xe4, 0x7c, 0xc9, 0x9f, 0xb3, 0xd1, 0x46, 0x69, 0x8d, 0x16, 0x46, 0x24,
+	0x4f, 0xc4, 0x5f, 0xb0, 0xb1, 0x44, 0x21, 0x97, 0x4a, 0x23, 0x5c, 0x53, 0xe7, 0xcc, 0xfc, 0x25,
+	0x8b, 0x2a, 0xb3, 0xc6, 0x0f, 0xa6, 0xd1, 0x0e, 0xc6, 0x49, 0x90, 0x0e, 0xb3, 0x5e, 0xf0, 0x84,
+	0xdd, 0xe4, 0x58, 0x1a, 0x8b, 0x9f, 0x69, 0xff, 0x88, 0x86, 0x2f, 0x15, 0x8f, 0x19, 0x13, 0xa5,
+	0x43, 0xdb, 0x06, 0x18, 0x05, 0x2e, 0x8c, 0xdf, 0x7d, 0x23, 0x66, 0x68, 0xe1, 0x86, 0x7e, 0xbb,
+	0x05, 0x6f, 0x25, 0x6a, 0x53, 0xc1, 0xc3, 0xf6, 0x46, 0x04, 0x7e, 0x17, 0x69, 0xc5, 0xe6, 0x4b,
+	0x59, 0xa2, 0x85, 0x5b, 0xea, 0xf4, 0x82, 0x03, 0xbb, 0x9e, 0x2b, 0xff, 0x15, 0x76, 0xf0, 0x28,
+	0x09, 0xd3, 0x28, 0xeb, 0xd0, 0x77, 0xd6, 0xc2, 0x2a, 0xa1, 0x1d, 0x3c, 0xa6, 0xa9, 0x0e, 0xfd,
+	0x39, 0xfe, 0x32, 0x35, 0xdc, 0xd1, 0x44, 0x0b, 0xfc, 0x0d, 0xbb, 0xad, 0x9d, 0xb0, 0x6e, 0x6a,
+	0x6a, 0xe5, 0x94, 0xd1, 0xf0, 0x84, 0xa6, 0xfe, 0x97, 0x7e, 0x1b, 0xfa, 0xa3, 0xa7, 0x42, 0x49,
+	0xe0, 0x49, 0x90, 0x8e, 0xb3, 0x5e, 0xf8, 0x33, 0x2d, 0x4a, 0xea, 0x3d, 0xa5, 0x5e, 0x87, 0xef,
+	0x3f, 0xfe, 0x3a, 0xc4, 0xc1, 0xfe, 0x10, 0x07, 0x7f, 0x0e, 0x71, 0xf0, 0xf3, 0x18, 0x0f, 0xf6,
+	0xc7, 0x78, 0xf0, 0xfb, 0x18, 0x0f, 0xbe, 0xbd, 0x9d, 0x29, 0x37, 0x6f, 0xf2, 0x49, 0x61, 0xaa,
+	0xfb, 0xf6, 0xed, 0xf4, 0xcf, 0x6b, 0xdb, 0x97, 0x6e, 0xb7, 0xc2, 0x3a, 0x1f, 0xd1, 0x23, 0x7b,
+	0xf7, 0x6f, 0x00, 0xa0, 0x36, 0x92, 0x44, 0x8b, 0x02, 0x00, 0x00,
 }
 
 func (m *StoredGame) Marshal() (dAtA []byte, err error) {
@@ -152,6 +267,111 @@ func (m *StoredGame) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RedPaid {
+		i--
+		if m.RedPaid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.BlackPaid {
+		i--
+		if m.BlackPaid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.StartPosition) > 0 {
+		i -= len(m.StartPosition)
+		copy(dAtA[i:], m.StartPosition)
+		i = encodeVarintStoredGame(dAtA, i, uint64(len(m.StartPosition)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.Moves) > 0 {
+		for iNdEx := len(m.Moves) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Moves[iNdEx])
+			copy(dAtA[i:], m.Moves[iNdEx])
+			i = encodeVarintStoredGame(dAtA, i, uint64(len(m.Moves[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.Variant) > 0 {
+		i -= len(m.Variant)
+		copy(dAtA[i:], m.Variant)
+		i = encodeVarintStoredGame(dAtA, i, uint64(len(m.Variant)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.History[iNdEx])
+			copy(dAtA[i:], m.History[iNdEx])
+			i = encodeVarintStoredGame(dAtA, i, uint64(len(m.History[iNdEx])))
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.DrawOffer) > 0 {
+		i -= len(m.DrawOffer)
+		copy(dAtA[i:], m.DrawOffer)
+		i = encodeVarintStoredGame(dAtA, i, uint64(len(m.DrawOffer)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintStoredGame(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Wager != 0 {
+		i = encodeVarintStoredGame(dAtA, i, uint64(m.Wager))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.AfterIndex) > 0 {
+		i -= len(m.AfterIndex)
+		copy(dAtA[i:], m.AfterIndex)
+		i = encodeVarintStoredGame(dAtA, i, uint64(len(m.AfterIndex)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.BeforeIndex) > 0 {
+		i -= len(m.BeforeIndex)
+		copy(dAtA[i:], m.BeforeIndex)
+		i = encodeVarintStoredGame(dAtA, i, uint64(len(m.BeforeIndex)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.MoveCount != 0 {
+		i = encodeVarintStoredGame(dAtA, i, uint64(m.MoveCount))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Deadline) > 0 {
+		i -= len(m.Deadline)
+		copy(dAtA[i:], m.Deadline)
+		i = encodeVarintStoredGame(dAtA, i, uint64(len(m.Deadline)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.Winner) > 0 {
 		i -= len(m.Winner)
 		copy(dAtA[i:], m.Winner)
@@ -238,6 +458,58 @@ func (m *StoredGame) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovStoredGame(uint64(l))
 	}
+	l = len(m.Deadline)
+	if l > 0 {
+		n += 1 + l + sovStoredGame(uint64(l))
+	}
+	if m.MoveCount != 0 {
+		n += 1 + sovStoredGame(uint64(m.MoveCount))
+	}
+	l = len(m.BeforeIndex)
+	if l > 0 {
+		n += 1 + l + sovStoredGame(uint64(l))
+	}
+	l = len(m.AfterIndex)
+	if l > 0 {
+		n += 1 + l + sovStoredGame(uint64(l))
+	}
+	if m.Wager != 0 {
+		n += 1 + sovStoredGame(uint64(m.Wager))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovStoredGame(uint64(l))
+	}
+	l = len(m.DrawOffer)
+	if l > 0 {
+		n += 1 + l + sovStoredGame(uint64(l))
+	}
+	if len(m.History) > 0 {
+		for _, s := range m.History {
+			l = len(s)
+			n += 1 + l + sovStoredGame(uint64(l))
+		}
+	}
+	l = len(m.Variant)
+	if l > 0 {
+		n += 1 + l + sovStoredGame(uint64(l))
+	}
+	if len(m.Moves) > 0 {
+		for _, s := range m.Moves {
+			l = len(s)
+			n += 2 + l + sovStoredGame(uint64(l))
+		}
+	}
+	l = len(m.StartPosition)
+	if l > 0 {
+		n += 2 + l + sovStoredGame(uint64(l))
+	}
+	if m.BlackPaid {
+		n += 3
+	}
+	if m.RedPaid {
+		n += 3
+	}
 	return n
 }
 
@@ -468,6 +740,372 @@ func (m *StoredGame) Unmarshal(dAtA []byte) error {
 			}
 			m.Winner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deadline = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MoveCount", wireType)
+			}
+			m.MoveCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MoveCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeforeIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BeforeIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AfterIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AfterIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wager", wireType)
+			}
+			m.Wager = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Wager |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrawOffer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DrawOffer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Variant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Variant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moves", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moves = append(m.Moves, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartPosition", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartPosition = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlackPaid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BlackPaid = bool(v != 0)
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedPaid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RedPaid = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStoredGame(dAtA[iNdEx:])
@@ -23,7 +23,9 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type SystemInfo struct {
-	NextId uint64 `protobuf:"varint,1,opt,name=nextId,proto3" json:"nextId,omitempty"`
+	NextId        uint64 `protobuf:"varint,1,opt,name=nextId,proto3" json:"nextId,omitempty"`
+	FifoHeadIndex string `protobuf:"bytes,2,opt,name=fifoHeadIndex,proto3" json:"fifoHeadIndex,omitempty"`
+	FifoTailIndex string `protobuf:"bytes,3,opt,name=fifoTailIndex,proto3" json:"fifoTailIndex,omitempty"`
 }
 
 func (m *SystemInfo) Reset()         { *m = SystemInfo{} }
@@ -66,6 +68,20 @@ func (m *SystemInfo) GetNextId() uint64 {
 	return 0
 }
 
+func (m *SystemInfo) GetFifoHeadIndex() string {
+	if m != nil {
+		return m.FifoHeadIndex
+	}
+	return ""
+}
+
+func (m *SystemInfo) GetFifoTailIndex() string {
+	if m != nil {
+		return m.FifoTailIndex
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*SystemInfo)(nil), "bekauz.checkers.checkers.SystemInfo")
 }
@@ -75,17 +91,19 @@ func init() {
 }
 
 var fileDescriptor_4fddf76acd3e854e = []byte{
-	// 154 bytes of a gzipped FileDescriptorProto
+	// 190 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4e, 0xce, 0x48, 0x4d,
 	0xce, 0x4e, 0x2d, 0x2a, 0xd6, 0x87, 0x33, 0x8a, 0x2b, 0x8b, 0x4b, 0x52, 0x73, 0xe3, 0x33, 0xf3,
 	0xd2, 0xf2, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x24, 0x92, 0x52, 0xb3, 0x13, 0x4b, 0xab,
-	0xf4, 0x60, 0x4a, 0xe0, 0x0c, 0x25, 0x15, 0x2e, 0xae, 0x60, 0xb0, 0x72, 0xcf, 0xbc, 0xb4, 0x7c,
+	0xf4, 0x60, 0x4a, 0xe0, 0x0c, 0xa5, 0x02, 0x2e, 0xae, 0x60, 0xb0, 0x72, 0xcf, 0xbc, 0xb4, 0x7c,
 	0x21, 0x31, 0x2e, 0xb6, 0xbc, 0xd4, 0x8a, 0x12, 0xcf, 0x14, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x96,
-	0x20, 0x28, 0xcf, 0xc9, 0xf5, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92,
-	0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0xb4,
-	0xd3, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x21, 0x96, 0x20, 0xdc, 0x51,
-	0x81, 0x60, 0x96, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x5d, 0x63, 0x0c, 0x08, 0x00, 0x00,
-	0xff, 0xff, 0x28, 0xf7, 0x9c, 0x3e, 0xb4, 0x00, 0x00, 0x00,
+	0x20, 0x28, 0x4f, 0x48, 0x85, 0x8b, 0x37, 0x2d, 0x33, 0x2d, 0xdf, 0x23, 0x35, 0x31, 0xc5, 0x33,
+	0x2f, 0x25, 0xb5, 0x42, 0x82, 0x49, 0x81, 0x51, 0x83, 0x33, 0x08, 0x55, 0x10, 0xa6, 0x2a, 0x24,
+	0x31, 0x33, 0x07, 0xa2, 0x8a, 0x19, 0xa1, 0x0a, 0x2e, 0xe8, 0xe4, 0x7a, 0xe2, 0x91, 0x1c, 0xe3,
+	0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c,
+	0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0xda, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9,
+	0xb9, 0xfa, 0x10, 0x07, 0x23, 0xfc, 0x54, 0x81, 0x60, 0x96, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1,
+	0x81, 0x7d, 0x66, 0x0c, 0x18, 0x00, 0x3d, 0xc9, 0x5b, 0x8f, 0x00, 0x01, 0x00, 0x00,
 }
 
 func (m *SystemInfo) Marshal() (dAtA []byte, err error) {
@@ -108,6 +126,20 @@ func (m *SystemInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FifoTailIndex) > 0 {
+		i -= len(m.FifoTailIndex)
+		copy(dAtA[i:], m.FifoTailIndex)
+		i = encodeVarintSystemInfo(dAtA, i, uint64(len(m.FifoTailIndex)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FifoHeadIndex) > 0 {
+		i -= len(m.FifoHeadIndex)
+		copy(dAtA[i:], m.FifoHeadIndex)
+		i = encodeVarintSystemInfo(dAtA, i, uint64(len(m.FifoHeadIndex)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.NextId != 0 {
 		i = encodeVarintSystemInfo(dAtA, i, uint64(m.NextId))
 		i--
@@ -136,6 +168,14 @@ func (m *SystemInfo) Size() (n int) {
 	if m.NextId != 0 {
 		n += 1 + sovSystemInfo(uint64(m.NextId))
 	}
+	l = len(m.FifoHeadIndex)
+	if l > 0 {
+		n += 1 + l + sovSystemInfo(uint64(l))
+	}
+	l = len(m.FifoTailIndex)
+	if l > 0 {
+		n += 1 + l + sovSystemInfo(uint64(l))
+	}
 	return n
 }
 
@@ -193,6 +233,70 @@ func (m *SystemInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FifoHeadIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSystemInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSystemInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSystemInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FifoHeadIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FifoTailIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSystemInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSystemInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSystemInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FifoTailIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSystemInfo(dAtA[iNdEx:])